@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/math"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
@@ -10,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Account interface {
@@ -23,6 +26,8 @@ type Account interface {
 	CreatePaymentAccount(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (string, error)
 	Transfer(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error)
 	MultiTransfer(ctx context.Context, details []types.TransferDetail, txOption gnfdSdkTypes.TxOption) (string, error)
+	// CreateAccountOnChain activates the new account by transferring a dust amount to it from the default account
+	CreateAccountOnChain(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (string, error)
 }
 
 // GetAccount retrieves account information for a given address.
@@ -59,7 +64,7 @@ func (c *client) CreatePaymentAccount(ctx context.Context, address string, txOpt
 		return "", err
 	}
 	msgCreatePaymentAccount := paymentTypes.NewMsgCreatePaymentAccount(accAddress.String())
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgCreatePaymentAccount}, &txOption)
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msgCreatePaymentAccount}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -171,20 +176,41 @@ func (c *client) GetPaymentAccountsByOwner(ctx context.Context, owner string) ([
 // If there is an error during the broadcasting, the function returns nil and the error. If there is no error,
 // the function returns a pointer to the TxResponse struct and nil
 func (c *client) Transfer(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	toAddr, err := sdk.AccAddressFromHexUnsafe(toAddress)
 	if err != nil {
 		return "", err
 	}
-	msgSend := bankTypes.NewMsgSend(c.MustGetDefaultAccount().GetAddress(), toAddr, sdk.Coins{sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount}})
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgSend}, &txOption)
+	msgSend := bankTypes.NewMsgSend(account.GetAddress(), toAddr, sdk.Coins{sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount}})
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msgSend}, &txOption)
 	if err != nil {
 		return "", err
 	}
 	return tx.TxResponse.TxHash, nil
 }
 
+// CreateAccountOnChain activates the account of the address, which is not known by the chain yet,
+// by transferring types.AccountActivationAmount from the default account to it.
+func (c *client) CreateAccountOnChain(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	_, err := c.GetAccount(ctx, address)
+	if err == nil {
+		return "", fmt.Errorf("account %s already exists on chain", address)
+	}
+	if status.Code(err) != codes.NotFound {
+		return "", err
+	}
+	return c.Transfer(ctx, address, math.NewInt(types.AccountActivationAmount), txOption)
+}
+
 // MultiTransfer makes transfers from an account to multiple accounts with respect amounts
 func (c *client) MultiTransfer(ctx context.Context, details []types.TransferDetail, txOption gnfdSdkTypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	outputs := make([]bankTypes.Output, 0)
 	denom := gnfdSdkTypes.Denom
 	sum := math.NewInt(0)
@@ -196,14 +222,14 @@ func (c *client) MultiTransfer(ctx context.Context, details []types.TransferDeta
 		sum = sum.Add(details[i].Amount)
 	}
 	in := bankTypes.Input{
-		Address: c.MustGetDefaultAccount().GetAddress().String(),
+		Address: account.GetAddress().String(),
 		Coins:   []sdk.Coin{{Denom: denom, Amount: sum}},
 	}
 	msg := &bankTypes.MsgMultiSend{
 		Inputs:  []bankTypes.Input{in},
 		Outputs: outputs,
 	}
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -2,13 +2,27 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cometbft/cometbft/votepool"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/errors"
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/eip712"
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
 	gosdktypes "github.com/bnb-chain/greenfield-go-sdk/types"
 	"github.com/bnb-chain/greenfield/sdk/types"
 	"github.com/cometbft/cometbft/proto/tendermint/p2p"
@@ -28,6 +42,8 @@ type Basic interface {
 	GetLatestBlockHeight(ctx context.Context) (int64, error)
 	GetLatestBlock(ctx context.Context) (*bfttypes.Block, error)
 	GetSyncing(ctx context.Context) (bool, error)
+	// GetSyncStatus returns the latest block of the node and estimates the number of the blocks it lags behind
+	GetSyncStatus(ctx context.Context) (*gosdktypes.SyncStatus, error)
 	GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.Block, error)
 	GetBlockResultByHeight(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error)
 
@@ -35,17 +51,67 @@ type Basic interface {
 	GetValidatorsByHeight(ctx context.Context, height int64) ([]*bfttypes.Validator, error)
 
 	WaitForBlockHeight(ctx context.Context, height int64) error
+	// WaitForTx waits for the tx to be committed. It returns types.TxNotIncludedError if the tx is not pending in the
+	// mempool any more after the blocks of Option.WaitTxMaxBlocks are waited, e.g. it is evicted for the low fee.
 	WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx, error)
 	WaitForNBlocks(ctx context.Context, n int64) error
 	WaitForNextBlock(ctx context.Context) error
 
 	SimulateTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (*tx.SimulateResponse, error)
 	SimulateRawTx(ctx context.Context, txBytes []byte, opts ...grpc.CallOption) (*tx.SimulateResponse, error)
+	// BroadcastTx signs and broadcasts the msgs, the gas price is decided by the fee policy of the client unless the
+	// fee is set in txOpt by NoSimulate. txOpt.Mode selects the broadcast mode:
+	//   - BROADCAST_MODE_SYNC (default) returns after the tx passes CheckTx, the response code reports the CheckTx failure
+	//   - BROADCAST_MODE_ASYNC returns right after the tx is sent to the node, which has the highest throughput but
+	//     reports no failure, the tx may never be committed
+	//   - BROADCAST_MODE_BLOCK returns after the tx is committed and the response carries the result of the execution,
+	//     it is emulated by the client as the nodes do not support it any more
 	BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error)
+	// BroadcastTxAndWait broadcasts the msgs in the mode of txOpt, e.g. async for throughput, and waits for the tx
+	// to be committed. An error is returned if the tx is rejected by CheckTx or fails in the execution.
+	BroadcastTxAndWait(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption) (*ctypes.ResultTx, error)
+	// ResubmitWithHigherFee rebuilds a tx recently sent by the client with the same msgs, sequence and gas limit, and
+	// broadcasts it with the fee multiplied by bumpFactor, e.g. 1.5. The mempool of the chain does not replace a
+	// pending tx of the same sequence, so the resubmission is mainly for the tx evicted during a gas spike, i.e.
+	// WaitForTx returns types.TxNotIncludedError. The bumped gas price is bounded by the MaxGasPrice of the fee policy.
+	ResubmitWithHigherFee(ctx context.Context, txHash string, bumpFactor float64) (*tx.BroadcastTxResponse, error)
+	// ResubmitMsgsWithHigherFee is like ResubmitWithHigherFee for the msgs not sent by this client, txOpt.Nonce should
+	// be the sequence of the stuck tx, and the fee is simulated before the bump unless it is set by NoSimulate.
+	ResubmitMsgsWithHigherFee(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, bumpFactor float64) (*tx.BroadcastTxResponse, error)
 	BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool) (*sdk.TxResponse, error)
+	// GetGasPriceInfo returns the minimum gas price of the connected node, the gas price decided by the fee policy of
+	// the client and the gas params of the transactions
+	GetGasPriceInfo(ctx context.Context) (*gosdktypes.GasPriceInfo, error)
+	// DryRunTx validates and simulates the msgs without broadcasting them, it returns the estimated gas and fee
+	DryRunTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption) (*gosdktypes.DryRunResult, error)
+	// BuildUnsignedTx builds the transaction of the msgs for the signer without signing it, the returned sign bytes
+	// can be signed on an offline machine and combined with the tx bytes by AssembleSignedTx
+	BuildUnsignedTx(ctx context.Context, msgs []sdk.Msg, signerPubKey cryptotypes.PubKey, txOpt types.TxOption) (*gosdktypes.UnsignedTx, error)
+	// AssembleSignedTx attaches the signature produced offline to the unsigned tx bytes returned by BuildUnsignedTx
+	AssembleSignedTx(unsignedTxBytes []byte, signature []byte) ([]byte, error)
+	// BroadcastSignedTx broadcasts the signed transaction bytes in sync mode after checking they carry the signature
+	BroadcastSignedTx(ctx context.Context, signedTxBytes []byte) (*sdk.TxResponse, error)
+	// NewMultisigTx builds the transaction of the msgs sent by the multisig account and returns a collector
+	// which gathers the partial signatures of its members
+	NewMultisigTx(ctx context.Context, msgs []sdk.Msg, multisigPubKey multisig.PubKey, txOpt types.TxOption) (*gosdktypes.SignatureCollector, error)
+	// BroadcastMultisigTx assembles the partial signatures gathered by the collector and broadcasts the tx in sync mode
+	BroadcastMultisigTx(ctx context.Context, collector *gosdktypes.SignatureCollector) (*sdk.TxResponse, error)
 
 	BroadcastVote(ctx context.Context, vote votepool.Vote) error
 	QueryVote(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVote, error)
+
+	// GetCodec returns the codec used by the client to encode and decode messages and transactions
+	GetCodec() *codec.ProtoCodec
+	// RegisterInterfaces registers custom msg types and interfaces into the InterfaceRegistry of the client,
+	// so that transactions containing them can be broadcast and decoded by the client
+	RegisterInterfaces(registerFns ...func(registry codectypes.InterfaceRegistry))
+	// DecodeTx decodes the raw transaction bytes into a transaction using the codec of the client
+	DecodeTx(txBytes []byte) (sdk.Tx, error)
+	// GetTxEvents waits for the transaction to be committed and returns its typed events,
+	// e.g. *storagetypes.EventCreateBucket, which can be picked by events.FindEvent
+	GetTxEvents(ctx context.Context, txHash string) ([]proto.Message, error)
+	// WaitForTxResult waits for the transaction to be committed and returns its hash, height and typed events
+	WaitForTxResult(ctx context.Context, txHash string) (*gosdktypes.TxResult, error)
 }
 
 // GetNodeInfo returns the current node info of the greenfield that the client is connected to.
@@ -71,6 +137,9 @@ func (c *client) GetCommit(ctx context.Context, height int64) (*ctypes.ResultCom
 // If sync is true, the transaction is broadcast synchronously.
 // If sync is false, the transaction is broadcast asynchronously.
 func (c *client) BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool) (*sdk.TxResponse, error) {
+	if c.readOnly {
+		return nil, gosdktypes.ErrorReadOnlyClient
+	}
 	var mode tx.BroadcastMode
 	if sync {
 		mode = tx.BroadcastMode_BROADCAST_MODE_SYNC
@@ -84,6 +153,264 @@ func (c *client) BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool)
 	return broadcastTxResponse.TxResponse, nil
 }
 
+// GetGasPriceInfo simulates a transfer of 1 wei from the default account to itself to get the minimum gas price of
+// the node, nothing is broadcast
+func (c *client) GetGasPriceInfo(ctx context.Context) (*gosdktypes.GasPriceInfo, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	msg := banktypes.NewMsgSend(account.GetAddress(), account.GetAddress(), sdk.NewCoins(sdk.NewCoin(types.Denom, sdk.OneInt())))
+	txOpt, err := c.withSigner(types.TxOption{})
+	if err != nil {
+		return nil, err
+	}
+	simulateRes, err := c.chainClient.SimulateTx(ctx, []sdk.Msg{msg}, &txOpt)
+	if err != nil {
+		return nil, err
+	}
+	minGasPrice, err := sdk.ParseCoinNormalized(simulateRes.GasInfo.GetMinGasPrice())
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := c.gasPrice(simulateRes.GasInfo.GetMinGasPrice())
+	if err != nil {
+		return nil, err
+	}
+	paramsRes, err := c.chainClient.GashubQueryClient.Params(ctx, &gashubtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &gosdktypes.GasPriceInfo{
+		MinGasPrice:   minGasPrice,
+		GasPrice:      gasPrice,
+		MaxTxSize:     paramsRes.Params.MaxTxSize,
+		MinGasPerByte: paramsRes.Params.MinGasPerByte,
+	}, nil
+}
+
+// DryRunTx performs the ValidateBasic of the msgs and simulates the transaction with the default account
+// or txOpt.OverrideKeyManager as the signer, the transaction is not broadcast.
+func (c *client) DryRunTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption) (*gosdktypes.DryRunResult, error) {
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	txOpt, err := c.withSigner(txOpt)
+	if err != nil {
+		return nil, err
+	}
+	simulateRes, err := c.chainClient.SimulateTx(ctx, msgs, &txOpt)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := simulateRes.GasInfo.GetGasUsed()
+	gasPrice, err := c.gasPrice(simulateRes.GasInfo.GetMinGasPrice())
+	if err != nil {
+		return nil, err
+	}
+	return &gosdktypes.DryRunResult{
+		Msgs:     msgs,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Fee:      sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(sdk.NewInt(int64(gasLimit))))),
+	}, nil
+}
+
+// BuildUnsignedTx builds the transaction of the msgs signed by the account of signerPubKey, without
+// accessing its private key. The gas limit and fee are simulated unless txOpt.NoSimulate is set, and the
+// nonce is fetched from chain unless txOpt.Nonce is set.
+func (c *client) BuildUnsignedTx(ctx context.Context, msgs []sdk.Msg, signerPubKey cryptotypes.PubKey, txOpt types.TxOption) (*gosdktypes.UnsignedTx, error) {
+	if signerPubKey == nil {
+		return nil, gosdktypes.ErrorSignerPubKeyNotSet
+	}
+	return c.buildUnsignedTx(ctx, msgs, signerPubKey, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_712}, txOpt)
+}
+
+// buildUnsignedTx builds the tx carrying the signer info of the pubkey with the empty signature data
+func (c *client) buildUnsignedTx(ctx context.Context, msgs []sdk.Msg, signerPubKey cryptotypes.PubKey, emptySigData signing.SignatureData, txOpt types.TxOption) (*gosdktypes.UnsignedTx, error) {
+	chainID, err := c.chainClient.GetChainId()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range msgs {
+		if err = m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	txConfig := eip712.NewTxConfig(c.chainClient.GetCodec())
+	txBuilder := txConfig.NewTxBuilder()
+	if err = txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	if txOpt.Memo != "" {
+		txBuilder.SetMemo(txOpt.Memo)
+	}
+	if !txOpt.FeePayer.Empty() {
+		txBuilder.SetFeePayer(txOpt.FeePayer)
+	}
+	if !txOpt.FeeGranter.Empty() {
+		txBuilder.SetFeeGranter(txOpt.FeeGranter)
+	}
+	if txOpt.Tip != nil {
+		txBuilder.SetTip(txOpt.Tip)
+	}
+
+	account, err := c.chainClient.GetAccountByAddr(ctx, sdk.AccAddress(signerPubKey.Address()))
+	if err != nil {
+		return nil, err
+	}
+	nonce := account.GetSequence()
+	if txOpt.Nonce != 0 {
+		nonce = txOpt.Nonce
+	}
+	// inject the signer info with an empty signature, it is needed for simulating and signing
+	emptySig := signing.SignatureV2{
+		PubKey:   signerPubKey,
+		Data:     emptySigData,
+		Sequence: nonce,
+	}
+	if err = txBuilder.SetSignatures(emptySig); err != nil {
+		return nil, err
+	}
+
+	if txOpt.NoSimulate {
+		if txOpt.GasLimit == 0 || txOpt.FeeAmount.IsZero() {
+			return nil, types.GasInfoNotProvidedError
+		}
+		txBuilder.SetGasLimit(txOpt.GasLimit)
+		txBuilder.SetFeeAmount(txOpt.FeeAmount)
+	} else {
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+		simulateRes, err := c.SimulateRawTx(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		gasLimit := simulateRes.GasInfo.GetGasUsed()
+		gasPrice, err := c.gasPrice(simulateRes.GasInfo.GetMinGasPrice())
+		if err != nil {
+			return nil, err
+		}
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(sdk.NewInt(int64(gasLimit))))))
+	}
+
+	unsignedTxBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	signBytes, err := eip712.GetSignBytes(txBuilder.GetTx(), chainID, account.GetAccountNumber(), nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &gosdktypes.UnsignedTx{
+		TxBytes:       unsignedTxBytes,
+		SignBytes:     signBytes,
+		ChainID:       chainID,
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      nonce,
+	}, nil
+}
+
+// AssembleSignedTx attaches the signature to the unsigned tx bytes returned by BuildUnsignedTx.
+// The public key and sequence of the signer are taken from the signer info carried by the unsigned tx.
+func (c *client) AssembleSignedTx(unsignedTxBytes []byte, signature []byte) ([]byte, error) {
+	txConfig := eip712.NewTxConfig(c.chainClient.GetCodec())
+	unsignedTx, err := txConfig.TxDecoder()(unsignedTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigTx, ok := unsignedTx.(xauthsigning.SigVerifiableTx)
+	if !ok {
+		return nil, gosdktypes.ErrorTxSignerInfoNotFound
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, errors.Wrapf(gosdktypes.ErrorTxSignerInfoNotFound, "expect one signer of the tx, got %d", len(sigs))
+	}
+	return eip712.EncodeSignedTx(txConfig, unsignedTx, sigs[0].PubKey, sigs[0].Sequence, signature)
+}
+
+// BroadcastSignedTx broadcasts the signed transaction bytes in sync mode.
+// It returns an error without broadcasting if the transaction carries no signature.
+func (c *client) BroadcastSignedTx(ctx context.Context, signedTxBytes []byte) (*sdk.TxResponse, error) {
+	signedTx, err := c.DecodeTx(signedTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigTx, ok := signedTx.(xauthsigning.SigVerifiableTx)
+	if !ok {
+		return nil, gosdktypes.ErrorTxSignerInfoNotFound
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) == 0 {
+		return nil, gosdktypes.ErrorTxNotSigned
+	}
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || len(data.Signature) == 0 {
+			return nil, gosdktypes.ErrorTxNotSigned
+		}
+	}
+	return c.BroadcastRawTx(ctx, signedTxBytes, true)
+}
+
+// NewMultisigTx builds the transaction of the msgs whose signer is the multisig account of multisigPubKey.
+// Each member signs the SignBytes of the returned collector and adds the signature by AddSignature,
+// the tx can be broadcast by BroadcastMultisigTx once the threshold of the multisig account is reached.
+func (c *client) NewMultisigTx(ctx context.Context, msgs []sdk.Msg, multisigPubKey multisig.PubKey, txOpt types.TxOption) (*gosdktypes.SignatureCollector, error) {
+	if multisigPubKey == nil {
+		return nil, gosdktypes.ErrorSignerPubKeyNotSet
+	}
+	emptySigData := multisig.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	unsignedTx, err := c.buildUnsignedTx(ctx, msgs, multisigPubKey, emptySigData, txOpt)
+	if err != nil {
+		return nil, err
+	}
+	return gosdktypes.NewSignatureCollector(unsignedTx, multisigPubKey), nil
+}
+
+// BroadcastMultisigTx assembles the partial signatures gathered by the collector into the multisig
+// signature and broadcasts the tx in sync mode.
+func (c *client) BroadcastMultisigTx(ctx context.Context, collector *gosdktypes.SignatureCollector) (*sdk.TxResponse, error) {
+	if collector == nil || !collector.IsComplete() {
+		return nil, gosdktypes.ErrorTxNotSigned
+	}
+	txConfig := eip712.NewTxConfig(c.chainClient.GetCodec())
+	unsignedTx, err := txConfig.TxDecoder()(collector.UnsignedTx.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := txConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	sig := signing.SignatureV2{
+		PubKey:   collector.PubKey(),
+		Data:     collector.MultiSignatureData(),
+		Sequence: collector.UnsignedTx.Sequence,
+	}
+	if err = txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+	signedTxBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return c.BroadcastRawTx(ctx, signedTxBytes, true)
+}
+
 // SimulateRawTx simulates the execution of a raw transaction on the blockchain without broadcasting it to the network.
 // It takes a context, transaction bytes, and any additional gRPC call options.
 // It returns a SimulateResponse object and an error (if any).
@@ -163,6 +490,7 @@ func (c *client) WaitForNBlocks(ctx context.Context, n int64) error {
 // WaitForTx requests the tx from hash, if not found, waits for next block and
 // tries again. Returns an error if ctx is canceled.
 func (c *client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx, error) {
+	var blocksWaited int64
 	for {
 		var (
 			txResponse *ctypes.ResultTx
@@ -183,21 +511,28 @@ func (c *client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx,
 			// Tx not found, wait for next block and try again
 			// If websocket conn is enabled, we also want to re-try the GetTx calls by having a timeout context
 			if strings.Contains(err.Error(), "not found") || (c.useWebsocketConn && (waitTxCtx.Err() == context.DeadlineExceeded)) {
-
+				if err := c.checkTxPending(ctx, hash, blocksWaited); err != nil {
+					return nil, err
+				}
 				err := c.WaitForNextBlock(ctx)
 				if err != nil {
 					return nil, errors.Wrap(err, "waiting for next block")
 				}
+				blocksWaited++
 				continue
 			}
 			return nil, errors.Wrapf(err, "fetching tx '%s'", hash)
 		}
 		// `nil` could mean the transaction is in the mempool, invalidated, or was not sent in the first place.
 		if txResponse == nil {
+			if err := c.checkTxPending(ctx, hash, blocksWaited); err != nil {
+				return nil, err
+			}
 			err := c.WaitForNextBlock(ctx)
 			if err != nil {
 				return nil, errors.Wrap(err, "waiting for next block")
 			}
+			blocksWaited++
 			continue
 		}
 		// Tx found
@@ -205,18 +540,121 @@ func (c *client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx,
 	}
 }
 
+// checkTxPending looks up the tx in the mempool every time the max blocks are waited, it returns TxNotIncludedError
+// if the tx is neither pending nor committed. The tx is regarded as pending if the mempool can not be inspected, e.g.
+// there are more unconfirmed txs than the limit of the query.
+func (c *client) checkTxPending(ctx context.Context, hash string, blocksWaited int64) error {
+	maxBlocks := c.waitTxMaxBlocks
+	if maxBlocks == 0 {
+		maxBlocks = gosdktypes.DefaultWaitTxMaxBlocks
+	}
+	if maxBlocks < 0 || blocksWaited == 0 || blocksWaited%maxBlocks != 0 {
+		return nil
+	}
+	limit := gosdktypes.MempoolQueryLimit
+	unconfirmedTxs, err := c.chainClient.GetUnconfirmedTxs(ctx, &limit)
+	if err != nil || unconfirmedTxs.Total > unconfirmedTxs.Count {
+		return nil
+	}
+	for _, unconfirmedTx := range unconfirmedTxs.Txs {
+		if strings.EqualFold(hex.EncodeToString(unconfirmedTx.Hash()), hash) {
+			return nil
+		}
+	}
+	// the tx may be committed after it is looked up
+	if txResponse, err := c.chainClient.Tx(ctx, hash); err == nil && txResponse != nil {
+		return nil
+	}
+	return &gosdktypes.TxNotIncludedError{TxHash: hash, Blocks: blocksWaited}
+}
+
 // BroadcastTx broadcasts a transaction containing the provided messages to the chain.
 // The function returns a pointer to a BroadcastTxResponse and any error that occurred during the operation.
 func (c *client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
-	return c.chainClient.BroadcastTx(ctx, msgs, &txOpt, opts...)
+	return c.broadcastTx(ctx, msgs, &txOpt, opts...)
+}
+
+// BroadcastTxAndWait waits for the tx for ContextTimeout at most, unless ctx has an earlier deadline
+func (c *client) BroadcastTxAndWait(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption) (*ctypes.ResultTx, error) {
+	resp, err := c.broadcastTx(ctx, msgs, &txOpt)
+	if err != nil {
+		return nil, err
+	}
+	txnHash := resp.TxResponse.TxHash
+	if resp.TxResponse.Code != 0 {
+		return nil, fmt.Errorf("the transaction %s is rejected with response code: %d, log: %s", txnHash, resp.TxResponse.Code, resp.TxResponse.RawLog)
+	}
+	ctxTimeout, cancel := context.WithTimeout(ctx, gosdktypes.ContextTimeout)
+	defer cancel()
+	txnResponse, err := c.WaitForTx(ctxTimeout, txnHash)
+	if err != nil {
+		return nil, fmt.Errorf("the transaction %s has been submitted, please check it later: %w", txnHash, err)
+	}
+	if txnResponse.TxResult.Code != 0 {
+		return txnResponse, fmt.Errorf("the transaction %s has failed with response code: %d, log: %s", txnHash, txnResponse.TxResult.Code, txnResponse.TxResult.Log)
+	}
+	return txnResponse, nil
 }
 
 // SimulateTx simulates a transaction containing the provided messages on the chain.
 // The function returns a pointer to a SimulateResponse and any error that occurred during the operation.
 func (c *client) SimulateTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (*tx.SimulateResponse, error) {
+	txOpt, err := c.withSigner(txOpt)
+	if err != nil {
+		return nil, err
+	}
 	return c.chainClient.SimulateTx(ctx, msgs, &txOpt, opts...)
 }
 
+// GetCodec returns the ProtoCodec shared with the chain client.
+func (c *client) GetCodec() *codec.ProtoCodec {
+	return c.chainClient.GetCodec()
+}
+
+// RegisterInterfaces registers custom msg types and interfaces into the InterfaceRegistry of the client.
+// It is usually called with the RegisterInterfaces function of a custom module, e.g.
+// client.RegisterInterfaces(mymoduletypes.RegisterInterfaces)
+func (c *client) RegisterInterfaces(registerFns ...func(registry codectypes.InterfaceRegistry)) {
+	registry := c.chainClient.GetCodec().InterfaceRegistry()
+	for _, registerFn := range registerFns {
+		registerFn(registry)
+	}
+}
+
+// DecodeTx decodes the raw transaction bytes into a transaction.
+// The msgs of the transaction must be registered in the InterfaceRegistry of the client.
+func (c *client) DecodeTx(txBytes []byte) (sdk.Tx, error) {
+	txConfig := authtx.NewTxConfig(c.chainClient.GetCodec(), []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})
+	return txConfig.TxDecoder()(txBytes)
+}
+
+// GetTxEvents waits for the transaction of the hash to be committed and decodes its typed events.
+// It returns an error if the transaction failed.
+func (c *client) GetTxEvents(ctx context.Context, txHash string) ([]proto.Message, error) {
+	txResult, err := c.WaitForTxResult(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	return txResult.Events, nil
+}
+
+// WaitForTxResult waits for the transaction of the hash to be committed and decodes its typed events.
+// It returns an error if the transaction failed.
+func (c *client) WaitForTxResult(ctx context.Context, txHash string) (*gosdktypes.TxResult, error) {
+	resultTx, err := c.WaitForTx(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if resultTx.TxResult.Code != 0 {
+		return nil, fmt.Errorf("the transaction %s has failed with response code: %d, log: %s", txHash, resultTx.TxResult.Code, resultTx.TxResult.Log)
+	}
+	txEvents, err := events.ParseResultTx(resultTx)
+	if err != nil {
+		return nil, err
+	}
+	return &gosdktypes.TxResult{Hash: txHash, Height: resultTx.Height, Events: txEvents}, nil
+}
+
 // GetSyncing retrieves the syncing status of the node. If true, means the node is catching up the latest block.
 // The function returns a boolean indicating whether the node is syncing and any error that occurred during the operation.
 func (c *client) GetSyncing(ctx context.Context) (bool, error) {
@@ -227,6 +665,37 @@ func (c *client) GetSyncing(ctx context.Context) (bool, error) {
 	return syncing.Syncing, nil
 }
 
+// GetSyncStatus estimates the lag of the node by dividing the age of its latest block by the average interval of
+// its recent types.SyncStatusSampleBlocks blocks, as the node does not know the height of the chain
+func (c *client) GetSyncStatus(ctx context.Context) (*gosdktypes.SyncStatus, error) {
+	status, err := c.chainClient.GetStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info := status.SyncInfo
+	syncStatus := &gosdktypes.SyncStatus{
+		CatchingUp:        info.CatchingUp,
+		LatestBlockHeight: info.LatestBlockHeight,
+		LatestBlockTime:   info.LatestBlockTime,
+	}
+	sampleHeight := info.LatestBlockHeight - gosdktypes.SyncStatusSampleBlocks
+	if sampleHeight < info.EarliestBlockHeight {
+		sampleHeight = info.EarliestBlockHeight
+	}
+	if sampleHeight < 1 || sampleHeight >= info.LatestBlockHeight {
+		return syncStatus, nil
+	}
+	header, err := c.chainClient.GetHeader(ctx, &sampleHeight)
+	if err != nil {
+		return nil, err
+	}
+	syncStatus.BlockInterval = info.LatestBlockTime.Sub(header.Header.Time) / time.Duration(info.LatestBlockHeight-sampleHeight)
+	if age := time.Since(info.LatestBlockTime); syncStatus.BlockInterval > 0 && age > syncStatus.BlockInterval {
+		syncStatus.BlocksBehind = int64(age / syncStatus.BlockInterval)
+	}
+	return syncStatus, nil
+}
+
 // GetBlockByHeight retrieves the block at the given height from the chain.
 // The function returns a pointer to a Block object and any error that occurred during the operation.
 func (c *client) GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.Block, error) {
@@ -260,6 +729,9 @@ func (c *client) GetValidatorsByHeight(ctx context.Context, height int64) ([]*bf
 }
 
 func (c *client) BroadcastVote(ctx context.Context, vote votepool.Vote) error {
+	if c.readOnly {
+		return gosdktypes.ErrorReadOnlyClient
+	}
 	return c.chainClient.BroadcastVote(ctx, vote)
 }
 
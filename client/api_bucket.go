@@ -16,17 +16,22 @@ import (
 	"strings"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	gnfdsdk "github.com/bnb-chain/greenfield/sdk/types"
 	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
 	"github.com/bnb-chain/greenfield/types/s3util"
 	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/rs/zerolog/log"
 
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
+	resourceUtils "github.com/bnb-chain/greenfield-go-sdk/pkg/resource"
 	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 )
@@ -36,10 +41,24 @@ type Bucket interface {
 	// CreateBucket get approval of creating bucket and send createBucket txn to greenfield chain
 	// primaryAddr indicates the HEX-encoded string of the primary storage provider address to which the bucket will be created
 	CreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (string, error)
+	// DryRunCreateBucket constructs the createBucket msg with the SP approval and simulates it without broadcasting
+	DryRunCreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*types.DryRunResult, error)
+	// BuildCreateBucketMsg constructs and validates the createBucket msg without broadcasting it, the approval
+	// signature of the primary SP is set in the PrimarySpApproval of the msg
+	BuildCreateBucketMsg(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*storageTypes.MsgCreateBucket, error)
+	// GetBucketIDFromTx waits for the CreateBucket transaction to be committed and returns the id of the created bucket
+	GetBucketIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error)
+	// CreateBucketAndWait creates the bucket like CreateBucket and waits for the transaction to be committed, the
+	// returned events carry the id of the bucket in *storageTypes.EventCreateBucket
+	CreateBucketAndWait(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*types.TxResult, error)
 	DeleteBucket(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (string, error)
+	// DeleteBucketAndWait deletes the bucket like DeleteBucket and waits for the transaction to be committed
+	DeleteBucketAndWait(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (*types.TxResult, error)
 
 	UpdateBucketVisibility(ctx context.Context, bucketName string, visibility storageTypes.VisibilityType, opt types.UpdateVisibilityOption) (string, error)
 	UpdateBucketInfo(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (string, error)
+	// UpdateBucketInfoAndWait updates the bucket like UpdateBucketInfo and waits for the transaction to be committed
+	UpdateBucketInfoAndWait(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (*types.TxResult, error)
 	UpdateBucketPaymentAddr(ctx context.Context, bucketName string, paymentAddr sdk.AccAddress, opt types.UpdatePaymentOption) (string, error)
 
 	HeadBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error)
@@ -47,21 +66,50 @@ type Bucket interface {
 	// PutBucketPolicy put the bucket policy to the principal, return the txn hash
 	// the principal can be generated by NewPrincipalWithAccount or NewPrincipalWithGroupId
 	PutBucketPolicy(ctx context.Context, bucketName string, principal types.Principal, statements []*permTypes.Statement, opt types.PutPolicyOption) (string, error)
+	// BuildPutBucketPolicyMsg constructs and validates the putPolicy msg of the bucket without broadcasting it
+	BuildPutBucketPolicyMsg(bucketName string, principal types.Principal, statements []*permTypes.Statement, opt types.PutPolicyOption) (*storageTypes.MsgPutPolicy, error)
 	// DeleteBucketPolicy delete the bucket policy of the principal，return the txn hash
 	// the principal can be generated by NewPrincipalWithAccount or NewPrincipalWithGroupId
 	DeleteBucketPolicy(ctx context.Context, bucketName string, principal types.Principal, opt types.DeletePolicyOption) (string, error)
 	// GetBucketPolicy get the bucket policy info of the user specified by principalAddr.
 	// principalAddr indicates the HEX-encoded string of the principal address
+	// The policy can be converted into the JSON format of the SDK by utils.NewGnfdPolicy to be displayed and re-applied
 	GetBucketPolicy(ctx context.Context, bucketName string, principalAddr string) (*permTypes.Policy, error)
+	// ShareBucketWithGroup allows the members of the group to perform the actions on the bucket and its objects,
+	// the policy expires at opt.PolicyExpireTime if it is set, return the txn hash
+	ShareBucketWithGroup(ctx context.Context, bucketName string, groupOwnerAddr string, groupName string, actions []permTypes.ActionType, opt types.PutPolicyOption) (string, error)
+	// UnshareBucketFromGroup deletes the bucket policy of the group which is put by ShareBucketWithGroup, return the txn hash
+	UnshareBucketFromGroup(ctx context.Context, bucketName string, groupOwnerAddr string, groupName string, opt types.DeletePolicyOption) (string, error)
 	// IsBucketPermissionAllowed check if the permission of bucket is allowed to the user.
 	// userAddr indicates the HEX-encoded string of the user address
 	IsBucketPermissionAllowed(ctx context.Context, userAddr string, bucketName string, action permTypes.ActionType) (permTypes.Effect, error)
 
 	ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (types.ListBucketsResult, error)
+	// ListBucketsAtHeight return an iterator which lazily lists the buckets owned by ownerAddr, or all the buckets if
+	// it is empty, in the chain state at the block height, see ListObjectsAtHeight. The chain does not index the
+	// buckets by the owner, so all the buckets of the chain are paged through.
+	ListBucketsAtHeight(ctx context.Context, ownerAddr string, height int64) *types.Iterator[*storageTypes.BucketInfo]
+	// ListDeletedBuckets lists the buckets of the default account which have been deleted and are still kept by the SP
+	// metadata service, the DeleteAt, DeleteReason and Operator of the returned buckets describe the deletions
+	ListDeletedBuckets(ctx context.Context, opts types.ListBucketsOptions) ([]*types.BucketMeta, error)
 	ListBucketReadRecord(ctx context.Context, bucketName string, opts types.ListReadRecordOptions) (types.QuotaRecordInfo, error)
 
 	BuyQuotaForBucket(ctx context.Context, bucketName string, targetQuota uint64, opt types.BuyQuotaOption) (string, error)
+	// GetBucketReadQuota returns the read quota of the bucket reported by the primary SP.
+	//
+	// Deprecated: use GetBucketQuotaSummary, which reports the charged quota of the chain and the SP quota separately.
 	GetBucketReadQuota(ctx context.Context, bucketName string) (types.QuotaInfo, error)
+	// GetBucketQuotaSummary returns the read quota of the bucket in the current month, which combines the charged
+	// quota on chain with the free quota and the consumed quota reported by the primary SP
+	GetBucketQuotaSummary(ctx context.Context, bucketName string) (*types.BucketQuotaSummary, error)
+	// GetQuotaUsageTrend aggregates the read records of the bucket in the current month into the daily consumption
+	// and projects the date when the remaining read quota will be exhausted
+	GetQuotaUsageTrend(ctx context.Context, bucketName string) (*types.QuotaUsageTrend, error)
+	// GetBucketActivity scans the blocks in the window and returns the chain events related to the bucket in the order
+	// they are emitted, e.g. the creation and deletion of the bucket and its objects, the updates of the bucket info
+	// including the charged quota, and the bucket policy changes. At most ActivityScanMaxBlocks blocks are scanned,
+	// and the scanned blocks must not have been pruned by the node.
+	GetBucketActivity(ctx context.Context, bucketName string, window types.ActivityWindow) ([]types.BucketActivity, error)
 	// ListBucketsByBucketID list buckets by bucket ids
 	ListBucketsByBucketID(ctx context.Context, bucketIds []uint64, opts types.EndPointOptions) (types.ListBucketsByBucketIDResponse, error)
 	GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg *storageTypes.MsgMigrateBucket) (*storageTypes.MsgMigrateBucket, error)
@@ -71,10 +119,13 @@ type Bucket interface {
 // GetCreateBucketApproval returns the signature info for the approval of preCreating resources
 func (c *client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *storageTypes.MsgCreateBucket) (*storageTypes.MsgCreateBucket, error) {
 	unsignedBytes := createBucketMsg.GetSignBytes()
+	if signedMsg, ok := c.loadCachedApproval(unsignedBytes); ok {
+		return signedMsg, nil
+	}
 
 	// set the action type
 	urlVal := make(url.Values)
-	urlVal["action"] = []string{types.CreateBucketAction}
+	urlVal["action"] = []string{string(types.CreateBucketAction)}
 
 	reqMeta := requestMeta{
 		urlValues:     urlVal,
@@ -86,10 +137,11 @@ func (c *client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *s
 	sendOpt := sendOptions{
 		method:     http.MethodGet,
 		isAdminApi: true,
+		timeout:    c.approvalTimeout,
 	}
 
 	primarySPAddr := createBucketMsg.GetPrimarySpAddress()
-	endpoint, err := c.getSPUrlByAddr(primarySPAddr)
+	endpoint, err := c.getSPUrlByAddr(ctx, primarySPAddr)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("route endpoint by addr: %s failed, err: %s", primarySPAddr, err.Error()))
 		return nil, err
@@ -113,41 +165,18 @@ func (c *client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *s
 
 	var signedMsg storageTypes.MsgCreateBucket
 	storageTypes.ModuleCdc.MustUnmarshalJSON(signedMsgBytes, &signedMsg)
+	c.cacheApproval(unsignedBytes, signedMsgBytes)
 
 	return &signedMsg, nil
 }
 
 // CreateBucket get approval of creating bucket and send createBucket txn to greenfield chain, it returns the transaction hash value and error
 func (c *client) CreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (string, error) {
-	address, err := sdk.AccAddressFromHexUnsafe(primaryAddr)
+	signedMsg, err := c.BuildCreateBucketMsg(ctx, bucketName, primaryAddr, opts)
 	if err != nil {
 		return "", err
 	}
-
-	var visibility storageTypes.VisibilityType
-	if opts.Visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
-		visibility = storageTypes.VISIBILITY_TYPE_PRIVATE // set default visibility type
-	} else {
-		visibility = opts.Visibility
-	}
-
-	var paymentAddr sdk.AccAddress
-	if opts.PaymentAddress != "" {
-		paymentAddr, err = sdk.AccAddressFromHexUnsafe(opts.PaymentAddress)
-		if err != nil {
-			return "", err
-		}
-	}
-
-	createBucketMsg := storageTypes.NewMsgCreateBucket(c.MustGetDefaultAccount().GetAddress(), bucketName,
-		visibility, address, paymentAddr, 0, nil, opts.ChargedQuota)
-
-	err = createBucketMsg.ValidateBasic()
-	if err != nil {
-		return "", err
-	}
-	signedMsg, err := c.GetCreateBucketApproval(ctx, createBucketMsg)
-	if err != nil {
+	if signedMsg, err = c.refreshCreateBucketApproval(ctx, signedMsg); err != nil {
 		return "", err
 	}
 
@@ -157,7 +186,7 @@ func (c *client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{signedMsg}, opts.TxOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{signedMsg}, opts.TxOpts)
 	if err != nil {
 		return "", err
 	}
@@ -180,19 +209,117 @@ func (c *client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 	return txnHash, nil
 }
 
+// CreateBucketAndWait sends the createBucket txn in async mode and waits for it to be committed
+func (c *client) CreateBucketAndWait(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*types.TxResult, error) {
+	opts.IsAsyncMode = true
+	txHash, err := c.CreateBucket(ctx, bucketName, primaryAddr, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
+// DryRunCreateBucket constructs the createBucket msg signed by the primary SP and simulates the transaction
+func (c *client) DryRunCreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*types.DryRunResult, error) {
+	signedMsg, err := c.BuildCreateBucketMsg(ctx, bucketName, primaryAddr, opts)
+	if err != nil {
+		return nil, err
+	}
+	var txOpts gnfdsdk.TxOption
+	if opts.TxOpts != nil {
+		txOpts = *opts.TxOpts
+	}
+	return c.DryRunTx(ctx, []sdk.Msg{signedMsg}, txOpts)
+}
+
+// BuildCreateBucketMsg constructs the createBucket msg and gets the approval of the primary SP
+func (c *client) BuildCreateBucketMsg(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (*storageTypes.MsgCreateBucket, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromHexUnsafe(primaryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var visibility storageTypes.VisibilityType
+	if opts.Visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storageTypes.VISIBILITY_TYPE_PRIVATE // set default visibility type
+	} else {
+		visibility = opts.Visibility
+	}
+
+	var paymentAddr sdk.AccAddress
+	if opts.PaymentAddress != "" {
+		paymentAddr, err = sdk.AccAddressFromHexUnsafe(opts.PaymentAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	createBucketMsg := storageTypes.NewMsgCreateBucket(account.GetAddress(), bucketName,
+		visibility, address, paymentAddr, 0, nil, opts.ChargedQuota)
+
+	err = createBucketMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetCreateBucketApproval(ctx, createBucketMsg)
+}
+
+// GetBucketIDFromTx returns the bucket id assigned by the CreateBucket transaction of the hash
+func (c *client) GetBucketIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error) {
+	txEvents, err := c.GetTxEvents(ctx, txHash)
+	if err != nil {
+		return storageTypes.Uint{}, err
+	}
+	event, ok := events.FindEvent[*storageTypes.EventCreateBucket](txEvents)
+	if !ok {
+		return storageTypes.Uint{}, types.ErrorEventNotFound
+	}
+	return event.BucketId, nil
+}
+
 // DeleteBucket send DeleteBucket txn to greenfield chain and return txn hash
 func (c *client) DeleteBucket(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
-	delBucketMsg := storageTypes.NewMsgDeleteBucket(c.MustGetDefaultAccount().GetAddress(), bucketName)
+	if err := c.checkDeleteProtection(bucketName, opt.Force); err != nil {
+		return "", err
+	}
+	delBucketMsg := storageTypes.NewMsgDeleteBucket(account.GetAddress(), bucketName)
 	return c.sendTxn(ctx, delBucketMsg, opt.TxOpts)
 }
 
+// DeleteBucketAndWait sends the DeleteBucket txn and waits for it to be committed
+func (c *client) DeleteBucketAndWait(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (*types.TxResult, error) {
+	txHash, err := c.DeleteBucket(ctx, bucketName, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
 // UpdateBucketVisibility update the visibilityType of bucket
 func (c *client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 	visibility storageTypes.VisibilityType, opt types.UpdateVisibilityOption,
 ) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
@@ -203,7 +330,7 @@ func (c *client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 		return "", err
 	}
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, visibility)
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(account.GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, visibility)
 	return c.sendTxn(ctx, updateBucketMsg, opt.TxOpts)
 }
 
@@ -211,17 +338,28 @@ func (c *client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 func (c *client) UpdateBucketPaymentAddr(ctx context.Context, bucketName string,
 	paymentAddr sdk.AccAddress, opt types.UpdatePaymentOption,
 ) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
 	}
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, bucketInfo.Visibility)
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(account.GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, bucketInfo.Visibility)
 	return c.sendTxn(ctx, updateBucketMsg, opt.TxOpts)
 }
 
 // UpdateBucketInfo update the bucket meta on chain, including read quota, payment address or visibility
 func (c *client) UpdateBucketInfo(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
@@ -259,7 +397,7 @@ func (c *client) UpdateBucketInfo(ctx context.Context, bucketName string, opts t
 		chargedReadQuota = bucketInfo.ChargedReadQuota
 	}
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName,
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(account.GetAddress(), bucketName,
 		&chargedReadQuota, paymentAddr, visibility)
 
 	// set the default txn broadcast mode as block mode
@@ -271,6 +409,15 @@ func (c *client) UpdateBucketInfo(ctx context.Context, bucketName string, opts t
 	return c.sendTxn(ctx, updateBucketMsg, opts.TxOpts)
 }
 
+// UpdateBucketInfoAndWait sends the UpdateBucketInfo txn and waits for it to be committed
+func (c *client) UpdateBucketInfoAndWait(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (*types.TxResult, error) {
+	txHash, err := c.UpdateBucketInfo(ctx, bucketName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
 // HeadBucket query the bucketInfo on chain, return the bucket info if exists
 // return err info if bucket not exist
 func (c *client) HeadBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error) {
@@ -304,27 +451,94 @@ func (c *client) HeadBucketByID(ctx context.Context, bucketID string) (*storageT
 func (c *client) PutBucketPolicy(ctx context.Context, bucketName string, principalStr types.Principal,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
 ) (string, error) {
-	resource := gnfdTypes.NewBucketGRN(bucketName)
+	putPolicyMsg, err := c.BuildPutBucketPolicyMsg(bucketName, principalStr, statements, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+}
+
+// BuildPutBucketPolicyMsg constructs the putPolicy msg of the bucket with the default account as the operator
+func (c *client) BuildPutBucketPolicyMsg(bucketName string, principalStr types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (*storageTypes.MsgPutPolicy, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	resource, err := resourceUtils.NewBucketGRN(bucketName)
+	if err != nil {
+		return nil, err
+	}
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	putPolicyMsg := storageTypes.NewMsgPutPolicy(c.MustGetDefaultAccount().GetAddress(), resource.String(),
+	putPolicyMsg := storageTypes.NewMsgPutPolicy(account.GetAddress(), resource,
 		principal, statements, opt.PolicyExpireTime)
-
-	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+	if err := putPolicyMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return putPolicyMsg, nil
 }
 
 // DeleteBucketPolicy delete the bucket policy of the principal
 func (c *client) DeleteBucketPolicy(ctx context.Context, bucketName string, principalStr types.Principal, opt types.DeletePolicyOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	resource := gnfdTypes.NewBucketGRN(bucketName).String()
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
 		return "", err
 	}
 
-	return c.sendDelPolicyTxn(ctx, c.MustGetDefaultAccount().GetAddress(), resource, principal, opt.TxOpts)
+	return c.sendDelPolicyTxn(ctx, account.GetAddress(), resource, principal, opt.TxOpts)
+}
+
+// ShareBucketWithGroup puts the bucket policy which allows the actions to the group
+func (c *client) ShareBucketWithGroup(ctx context.Context, bucketName string, groupOwnerAddr string, groupName string,
+	actions []permTypes.ActionType, opt types.PutPolicyOption,
+) (string, error) {
+	if len(actions) == 0 {
+		return "", errors.New("no action is specified to share the bucket")
+	}
+	principal, err := c.newGroupPrincipal(ctx, groupOwnerAddr, groupName)
+	if err != nil {
+		return "", err
+	}
+	// the statement without resources is ignored when the objects are accessed, so the objects of the bucket
+	// are specified as the resources, which does not affect the bucket actions
+	objectsGRN, err := resourceUtils.NewObjectGRN(bucketName, "*")
+	if err != nil {
+		return "", err
+	}
+	resources := []string{objectsGRN}
+	statement := utils.NewStatement(actions, permTypes.EFFECT_ALLOW, resources, types.NewStatementOptions{})
+	return c.PutBucketPolicy(ctx, bucketName, principal, []*permTypes.Statement{&statement}, opt)
+}
+
+// UnshareBucketFromGroup deletes the bucket policy of the group
+func (c *client) UnshareBucketFromGroup(ctx context.Context, bucketName string, groupOwnerAddr string, groupName string,
+	opt types.DeletePolicyOption,
+) (string, error) {
+	principal, err := c.newGroupPrincipal(ctx, groupOwnerAddr, groupName)
+	if err != nil {
+		return "", err
+	}
+	return c.DeleteBucketPolicy(ctx, bucketName, principal, opt)
+}
+
+// newGroupPrincipal returns the principal of the group identified by the owner and the name
+func (c *client) newGroupPrincipal(ctx context.Context, groupOwnerAddr string, groupName string) (types.Principal, error) {
+	groupInfo, err := c.HeadGroup(ctx, groupName, groupOwnerAddr)
+	if err != nil {
+		return "", err
+	}
+	return utils.NewPrincipalWithGroupId(groupInfo.Id.Uint64())
 }
 
 // IsBucketPermissionAllowed check if the permission of bucket is allowed to the user.
@@ -372,12 +586,16 @@ func (c *client) GetBucketPolicy(ctx context.Context, bucketName string, princip
 
 // ListBuckets list buckets for the owner
 func (c *client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (types.ListBucketsResult, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return types.ListBucketsResult{}, err
+	}
 	params := url.Values{}
 	params.Set("include-removed", strconv.FormatBool(opts.ShowRemovedBucket))
 	reqMeta := requestMeta{
 		urlValues:     params,
 		contentSHA256: types.EmptyStringSHA256,
-		userAddress:   c.MustGetDefaultAccount().GetAddress().String(),
+		userAddress:   account.GetAddress().String(),
 	}
 
 	sendOpt := sendOptions{
@@ -385,7 +603,7 @@ func (c *client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions)
 		disableCloseBody: true,
 	}
 
-	endpoint, err := c.getEndpointByOpt(opts.EndPointOptions)
+	endpoint, err := c.getEndpointByOpt(ctx, opts.EndPointOptions)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
 		return types.ListBucketsResult{}, err
@@ -418,6 +636,23 @@ func (c *client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions)
 	return listBucketsResult, nil
 }
 
+// ListDeletedBuckets lists the buckets including the removed ones and returns the removed buckets
+func (c *client) ListDeletedBuckets(ctx context.Context, opts types.ListBucketsOptions) ([]*types.BucketMeta, error) {
+	opts.ShowRemovedBucket = true
+	result, err := c.ListBuckets(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedBuckets := make([]*types.BucketMeta, 0)
+	for _, bucketMeta := range result.Buckets {
+		if bucketMeta.Removed {
+			deletedBuckets = append(deletedBuckets, bucketMeta)
+		}
+	}
+	return deletedBuckets, nil
+}
+
 // ListBucketReadRecord returns the read record of this month, the return items should be no more than maxRecords
 // ListReadRecordOption indicates the start timestamp of return read records
 func (c *client) ListBucketReadRecord(ctx context.Context, bucketName string, opts types.ListReadRecordOptions) (types.QuotaRecordInfo, error) {
@@ -488,19 +723,44 @@ func (c *client) ListBucketReadRecord(ctx context.Context, bucketName string, op
 }
 
 // GetBucketReadQuota return quota info of bucket of current month, include chain quota, free quota and consumed quota
+//
+// Deprecated: use GetBucketQuotaSummary instead.
 func (c *client) GetBucketReadQuota(ctx context.Context, bucketName string) (types.QuotaInfo, error) {
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return types.QuotaInfo{}, err
 	}
+	return c.getSPReadQuota(ctx, bucketName, time.Now().Format(types.QuotaMonthLayout))
+}
 
-	year, month, _ := time.Now().Date()
-	var date string
-	if int(month) < 10 {
-		date = strconv.Itoa(year) + "-" + "0" + strconv.Itoa(int(month))
-	} else {
-		date = strconv.Itoa(year) + "-" + strconv.Itoa(int(month))
+// GetBucketQuotaSummary queries the charged quota of the bucket from the chain and the free and consumed quota of the
+// current month from the primary SP
+func (c *client) GetBucketQuotaSummary(ctx context.Context, bucketName string) (*types.BucketQuotaSummary, error) {
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	month := time.Now().Format(types.QuotaMonthLayout)
+	spQuota, err := c.getSPReadQuota(ctx, bucketName, month)
+	if err != nil {
+		return nil, err
 	}
 
+	return &types.BucketQuotaSummary{
+		BucketName:    bucketName,
+		BucketID:      bucketInfo.Id.String(),
+		Month:         month,
+		ChargedQuota:  bucketInfo.ChargedReadQuota,
+		SPFreeQuota:   spQuota.SPFreeReadQuotaSize,
+		ConsumedQuota: spQuota.ReadConsumedSize,
+	}, nil
+}
+
+// getSPReadQuota queries the read quota of the bucket in the month from the primary SP
+func (c *client) getSPReadQuota(ctx context.Context, bucketName string, date string) (types.QuotaInfo, error) {
 	params := url.Values{}
 	params.Add("read-quota", "")
 	params.Add("year-month", date)
@@ -538,9 +798,241 @@ func (c *client) GetBucketReadQuota(ctx context.Context, bucketName string) (typ
 	return QuotaResult, nil
 }
 
+// GetQuotaUsageTrend lists all the read records of the bucket in the current month page by page, aggregates them by
+// day and projects the exhaustion of the remaining quota
+func (c *client) GetQuotaUsageTrend(ctx context.Context, bucketName string) (*types.QuotaUsageTrend, error) {
+	quota, err := c.GetBucketQuotaSummary(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monthStart := today.AddDate(0, 0, -today.Day()+1)
+	nextMonth := monthStart.AddDate(0, 1, 0)
+
+	days := today.Day()
+	daily := make([]types.DailyReadUsage, days)
+	for i := range daily {
+		daily[i].Date = monthStart.AddDate(0, 0, i)
+	}
+
+	startTimestamp := monthStart.UnixMicro()
+	for {
+		records, err := c.ListBucketReadRecord(ctx, bucketName, types.ListReadRecordOptions{
+			StartTimeStamp: startTimestamp,
+			MaxRecords:     types.ReadRecordPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records.ReadRecords {
+			readTime := time.UnixMicro(record.ReadTimestampUs).In(now.Location())
+			if day := readTime.Day() - 1; !readTime.Before(monthStart) && day < days {
+				daily[day].ReadSize += record.ReadSize
+				daily[day].ReadCount++
+			}
+		}
+		// the records have been listed entirely if the next start is not advanced
+		if len(records.ReadRecords) < types.ReadRecordPageSize || records.NextStartTimestampUs <= startTimestamp {
+			break
+		}
+		startTimestamp = records.NextStartTimestampUs
+	}
+
+	trend := &types.QuotaUsageTrend{
+		BucketName:        bucketName,
+		Quota:             *quota,
+		Remaining:         quota.RemainingQuota(),
+		Daily:             daily,
+		AverageDailyUsage: quota.ConsumedQuota / uint64(days),
+	}
+	// the projection beyond the range of time.Duration is not meaningful
+	if remainingDays := float64(trend.Remaining) / float64(trend.AverageDailyUsage); trend.AverageDailyUsage > 0 && remainingDays < 100000 {
+		exhaustion := now.Add(time.Duration(remainingDays * float64(24*time.Hour)))
+		trend.ProjectedExhaustion = &exhaustion
+		trend.ExhaustedBeforeReset = exhaustion.Before(nextMonth)
+	}
+	return trend, nil
+}
+
+// GetBucketActivity resolves the window into the block heights, then decodes the typed events of the block results
+// one by one, the header of a block is only queried if it contains the events of the bucket
+func (c *client) GetBucketActivity(ctx context.Context, bucketName string, window types.ActivityWindow) ([]types.BucketActivity, error) {
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	fromHeight, toHeight, err := c.resolveActivityWindow(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	if toHeight-fromHeight+1 > types.ActivityScanMaxBlocks {
+		return nil, fmt.Errorf("the window from height %d to %d exceeds the max %d blocks to scan", fromHeight, toHeight, types.ActivityScanMaxBlocks)
+	}
+
+	matcher := &bucketActivityMatcher{bucketName: bucketName, policyIDs: make(map[string]bool)}
+	// the policies put before the window are matched by the id of the current bucket
+	if bucketInfo, err := c.HeadBucket(ctx, bucketName); err == nil {
+		matcher.bucketID = bucketInfo.Id.String()
+	}
+
+	var activities []types.BucketActivity
+	for height := fromHeight; height <= toHeight; height++ {
+		blockResults, err := c.GetBlockResultByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the block results of height %d: %w", height, err)
+		}
+
+		var blockActivities []types.BucketActivity
+		// txIndexes records the index of the transaction of each activity, -1 for the events of the block
+		var txIndexes []int
+		collect := func(abciEvents []abci.Event, txIndex int) error {
+			typedEvents, err := events.ParseEvents(abciEvents)
+			if err != nil {
+				return err
+			}
+			for _, typedEvent := range typedEvents {
+				if matcher.match(typedEvent) {
+					blockActivities = append(blockActivities, types.BucketActivity{Height: height, Event: typedEvent})
+					txIndexes = append(txIndexes, txIndex)
+				}
+			}
+			return nil
+		}
+		if err = collect(blockResults.BeginBlockEvents, -1); err != nil {
+			return nil, err
+		}
+		for i, txResult := range blockResults.TxsResults {
+			if txResult.Code != 0 {
+				continue
+			}
+			if err = collect(txResult.Events, i); err != nil {
+				return nil, err
+			}
+		}
+		if err = collect(blockResults.EndBlockEvents, -1); err != nil {
+			return nil, err
+		}
+		if len(blockActivities) == 0 {
+			continue
+		}
+
+		block, err := c.GetBlockByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the block of height %d: %w", height, err)
+		}
+		for i := range blockActivities {
+			blockActivities[i].Time = block.Time
+			if txIndex := txIndexes[i]; txIndex >= 0 && txIndex < len(block.Txs) {
+				blockActivities[i].TxHash = fmt.Sprintf("%X", block.Txs[txIndex].Hash())
+			}
+		}
+		activities = append(activities, blockActivities...)
+	}
+	return activities, nil
+}
+
+// resolveActivityWindow returns the inclusive range of the block heights of the window
+func (c *client) resolveActivityWindow(ctx context.Context, window types.ActivityWindow) (int64, int64, error) {
+	if err := window.Validate(); err != nil {
+		return 0, 0, err
+	}
+	status, err := c.GetStatus(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	earliest, latest := status.SyncInfo.EarliestBlockHeight, status.SyncInfo.LatestBlockHeight
+	if earliest < 1 {
+		earliest = 1
+	}
+
+	fromHeight, toHeight := window.FromHeight, window.ToHeight
+	if fromHeight == 0 && !window.Since.IsZero() {
+		if fromHeight, err = c.searchHeightByTime(ctx, window.Since, earliest, latest); err != nil {
+			return 0, 0, err
+		}
+	}
+	if toHeight == 0 && !window.Until.IsZero() {
+		// the last block committed before Until is the one before the first block committed at or after it
+		if toHeight, err = c.searchHeightByTime(ctx, window.Until, earliest, latest); err != nil {
+			return 0, 0, err
+		}
+		if block, err := c.GetBlockByHeight(ctx, toHeight); err != nil {
+			return 0, 0, err
+		} else if !block.Time.Before(window.Until) {
+			toHeight--
+		}
+	}
+	if fromHeight < earliest {
+		fromHeight = earliest
+	}
+	if toHeight == 0 || toHeight > latest {
+		toHeight = latest
+	}
+	if fromHeight > toHeight {
+		return 0, 0, fmt.Errorf("the window from height %d to %d is empty", fromHeight, toHeight)
+	}
+	return fromHeight, toHeight, nil
+}
+
+// searchHeightByTime binary searches the first block committed at or after t between the heights low and high,
+// it returns high if all the blocks are committed before t
+func (c *client) searchHeightByTime(ctx context.Context, t time.Time, low, high int64) (int64, error) {
+	for low < high {
+		mid := low + (high-low)/2
+		block, err := c.GetBlockByHeight(ctx, mid)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query the block of height %d: %w", mid, err)
+		}
+		if block.Time.Before(t) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, nil
+}
+
+// bucketActivityMatcher matches the typed events related to the bucket, the ids of the bucket and its policies are
+// learned from the events, so that the recreated bucket and the deleted policies are tracked
+type bucketActivityMatcher struct {
+	bucketName string
+	bucketID   string
+	policyIDs  map[string]bool
+}
+
+func (m *bucketActivityMatcher) match(event proto.Message) bool {
+	switch e := event.(type) {
+	case *storageTypes.EventCreateBucket:
+		if e.BucketName != m.bucketName {
+			return false
+		}
+		m.bucketID = e.BucketId.String()
+		return true
+	case *storageTypes.EventCopyObject:
+		return e.SrcBucketName == m.bucketName || e.DstBucketName == m.bucketName
+	case *permTypes.EventPutPolicy:
+		if e.ResourceType != resource.RESOURCE_TYPE_BUCKET || m.bucketID == "" || e.ResourceId.String() != m.bucketID {
+			return false
+		}
+		m.policyIDs[e.PolicyId.String()] = true
+		return true
+	case *permTypes.EventDeletePolicy:
+		return m.policyIDs[e.PolicyId.String()]
+	case interface{ GetBucketName() string }:
+		return e.GetBucketName() == m.bucketName
+	default:
+		return false
+	}
+}
+
 // BuyQuotaForBucket buy the target quota of the specific bucket
 // targetQuota indicates the target quota to set for the bucket
 func (c *client) BuyQuotaForBucket(ctx context.Context, bucketName string, targetQuota uint64, opt types.BuyQuotaOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
@@ -550,9 +1042,9 @@ func (c *client) BuyQuotaForBucket(ctx context.Context, bucketName string, targe
 	if err != nil {
 		return "", err
 	}
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &targetQuota, paymentAddr, bucketInfo.Visibility)
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(account.GetAddress(), bucketName, &targetQuota, paymentAddr, bucketInfo.Visibility)
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{updateBucketMsg}, opt.TxOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{updateBucketMsg}, opt.TxOpts)
 	if err != nil {
 		return "", err
 	}
@@ -597,7 +1089,7 @@ func (c *client) ListBucketsByBucketID(ctx context.Context, bucketIds []uint64,
 		disableCloseBody: true,
 	}
 
-	endpoint, err := c.getEndpointByOpt(&opts)
+	endpoint, err := c.getEndpointByOpt(ctx, &opts)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
 		return types.ListBucketsByBucketIDResponse{}, err
@@ -634,7 +1126,7 @@ func (c *client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg
 
 	// set the action type
 	urlVal := make(url.Values)
-	urlVal["action"] = []string{types.MigrateBucketAction}
+	urlVal["action"] = []string{string(types.MigrateBucketAction)}
 
 	reqMeta := requestMeta{
 		urlValues:     urlVal,
@@ -646,10 +1138,11 @@ func (c *client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg
 	sendOpt := sendOptions{
 		method:     http.MethodGet,
 		isAdminApi: true,
+		timeout:    c.approvalTimeout,
 	}
 
 	primarySPID := migrateBucketMsg.DstPrimarySpId
-	endpoint, err := c.getSPUrlByID(primarySPID)
+	endpoint, err := c.getSPUrlByID(ctx, primarySPID)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("route endpoint by addr: %d failed, err: %s", primarySPID, err.Error()))
 		return nil, err
@@ -678,9 +1171,13 @@ func (c *client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg
 
 // MigrateBucket get approval of migrating bucket and send migrateBucket txn to greenfield chain, it returns the transaction hash value and error
 func (c *client) MigrateBucket(ctx context.Context, bucketName string, opts types.MigrateBucketOptions) (string, error) {
-	migrateBucketMsg := storageTypes.NewMsgMigrateBucket(c.MustGetDefaultAccount().GetAddress(), bucketName, opts.DstPrimarySPID)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	migrateBucketMsg := storageTypes.NewMsgMigrateBucket(account.GetAddress(), bucketName, opts.DstPrimarySPID)
 
-	err := migrateBucketMsg.ValidateBasic()
+	err = migrateBucketMsg.ValidateBasic()
 	if err != nil {
 		return "", err
 	}
@@ -688,6 +1185,9 @@ func (c *client) MigrateBucket(ctx context.Context, bucketName string, opts type
 	if err != nil {
 		return "", err
 	}
+	if signedMsg, err = c.refreshMigrateBucketApproval(ctx, signedMsg); err != nil {
+		return "", err
+	}
 
 	// set the default txn broadcast mode as block mode
 	if opts.TxOpts == nil {
@@ -695,7 +1195,7 @@ func (c *client) MigrateBucket(ctx context.Context, bucketName string, opts type
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{signedMsg}, opts.TxOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{signedMsg}, opts.TxOpts)
 	if err != nil {
 		return "", err
 	}
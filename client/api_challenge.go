@@ -82,7 +82,7 @@ func (c *client) GetChallengeInfo(ctx context.Context, objectID string, pieceInd
 		}
 	} else if opts.SPAddress != "" {
 		// get endpoint from sp address
-		endpoint, err = c.getSPUrlByAddr(opts.SPAddress)
+		endpoint, err = c.getSPUrlByAddr(ctx, opts.SPAddress)
 		if err != nil {
 			log.Error().Msg(fmt.Sprintf("route endpoint by sp address: %s failed, err: %v", opts.SPAddress, err))
 			return types.ChallengeResult{}, err
@@ -104,7 +104,7 @@ func (c *client) GetChallengeInfo(ctx context.Context, objectID string, pieceInd
 		} else {
 			// get endpoint of the secondary sp
 			secondarySPID := objectDetail.GlobalVirtualGroup.SecondarySpIds[redundancyIndex]
-			endpoint, err = c.getSPUrlByID(secondarySPID)
+			endpoint, err = c.getSPUrlByID(ctx, secondarySPID)
 			if err != nil {
 				log.Error().Msg(fmt.Sprintf("route endpoint by sp address: %d failed, err: %v", secondarySPID, err))
 				return types.ChallengeResult{}, err
@@ -152,7 +152,7 @@ func (c *client) SubmitChallenge(ctx context.Context, challengerAddress, spOpera
 		return nil, err
 	}
 	msg := challengetypes.NewMsgSubmit(challenger, spOperator, bucketName, objectName, randomIndex, segmentIndex)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +182,7 @@ func (c *client) AttestChallenge(ctx context.Context, submitterAddress, challeng
 	}
 
 	msg := challengetypes.NewMsgAttest(submitter, challengeId, objectId, spOperatorAddress, voteResult, challengerAddress, voteValidatorSet, VoteAggSignature)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/xml"
 	"errors"
@@ -14,8 +15,10 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
@@ -27,11 +30,24 @@ import (
 	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 	types2 "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+	"github.com/cometbft/cometbft/light"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+// Client is safe for concurrent use by multiple goroutines. The default account, the sequences of the accounts and
+// the cached state are synchronized internally, and the txs of an account are broadcast in the order of their
+// sequences, so a single Client should be shared rather than creating one per goroutine.
+//
+// The methods of every domain are promoted to Client, and the domains are also returned by the accessors, e.g.
+// client.Bucket().HeadBucket, which narrows the method set to pass a domain to the code depending on it only.
 type Client interface {
 	Basic
 	Bucket
@@ -48,10 +64,34 @@ type Client interface {
 	FeeGrant
 	VirtualGroup
 	OffChainAuth
+	State
+	Permission
+	VerifiedQuery
+
+	// Bucket returns the APIs of the buckets
+	Bucket() Bucket
+	// Object returns the APIs of the objects
+	Object() Object
+	// Group returns the APIs of the groups
+	Group() Group
+	// Permission returns the APIs of the policies
+	Permission() Permission
+	// Payment returns the APIs of the payment accounts
+	Payment() Payment
+	// SP returns the APIs of the storage providers
+	SP() SP
+	// Chain returns the APIs of the blocks, the txs and the status of the chain
+	Chain() Basic
 
 	GetDefaultAccount() (*types.Account, error)
 	SetDefaultAccount(account *types.Account)
 	EnableTrace(outputStream io.Writer, onlyTraceErr bool)
+	// EnableRequestDump dumps the signed requests sent to SP and the responses to the output, including the canonical
+	// request which is signed and the equivalent curl command, e.g. for reproducing the signature mismatches
+	EnableRequestDump(output io.Writer, opts types.RequestDumpOptions)
+	// Close stops the background goroutines of the client, e.g. the SP health checker, and releases the connections
+	// owned by the client. The client should not be used after it is closed, and closing it again is a no-op.
+	Close() error
 }
 
 // client represents a Greenfield SDK client that can interact with the blockchain
@@ -63,43 +103,397 @@ type client struct {
 	httpClient *http.Client
 	// Service provider endpoints
 	storageProviders map[uint32]*types.StorageProvider
-	// The default account to use when sending transactions.
+	spMutex          sync.RWMutex
+	// The default account to use when sending transactions, it also guards the key manager of the chain client
 	defaultAccount *types.Account
+	accountMutex   sync.RWMutex
 	// Whether the connection to the blockchain node is secure (HTTPS) or not (HTTP).
 	secure bool
 	// Host is the target sp server hostname，it is the host info in the request which sent to SP
 	host string
 	// The user agent info
 	userAgent string
+	// The identifier of the application sent in the X-Gnfd-App-ID header
+	appID string
 	// define if trace the error request to SP
 	isTraceEnabled     bool
 	traceOutput        io.Writer
 	onlyTraceError     bool
+	traceMutex         sync.Mutex
 	offChainAuthOption *OffChainAuthOption
 	useWebsocketConn   bool
 	expireSeconds      uint64
+	// the health info of the in-service SPs collected by the background health checker
+	spHealth      map[uint32]*types.SPHealth
+	spHealthMutex sync.RWMutex
+	// the patterns of the buckets and objects which can not be deleted without the Force option
+	deleteProtectionPatterns []string
+	// the storage params cached for paramsCacheTTL, the cache is disabled if paramsCacheTTL is zero
+	paramsCacheTTL   time.Duration
+	paramsCache      *storageTypes.Params
+	paramsCacheTime  time.Time
+	paramsCacheMutex sync.Mutex
+	// the default total timeout of the requests sent to SP
+	requestTimeout time.Duration
+	// the total timeout of the approval requests sent to SP, requestTimeout is used if it is zero
+	approvalTimeout time.Duration
+	// the retry policy of the upload requests, the defaults of types are used if they are zero
+	uploadMaxTryTime   int
+	uploadBackOffDelay time.Duration
+	// the createBucket approvals cached for approvalCacheTTL, the cache is disabled if approvalCacheTTL is zero
+	approvalCacheTTL   time.Duration
+	approvalCache      map[string]*cachedApproval
+	approvalCacheMutex sync.Mutex
+	// the client is created by NewReadOnlyGnfdClient
+	readOnly bool
+	// the disk cache of the object payloads, it is nil if the cache is disabled
+	objectCache *objectDiskCache
+
+	// the output of the signed request dump, the dump is disabled if it is nil
+	requestDumpOutput io.Writer
+	requestDumpOpts   types.RequestDumpOptions
+	requestDumpMutex  sync.Mutex
+
+	// the clock of the signing time, the system time is used if it is nil
+	clock types.Clock
+
+	// the policy of the gas price of the transactions, the gas price suggested by the chain is used if it is nil
+	feePolicy *types.FeePolicy
+	// the number of the blocks after which WaitForTx checks whether the tx is still pending in the mempool
+	waitTxMaxBlocks int64
+	// the store of the createObject approvals, which persists the approvals until the objects are uploaded
+	approvalStore types.ApprovalStore
+	// the resolver overriding the on-chain endpoints of the SPs
+	spEndpointResolver types.SPEndpointResolver
+	// whether the account and the balance of the sender are checked before broadcasting the txs
+	preflightChecks bool
+
+	// the txs recently broadcast by the client, which are kept for the resubmission with a higher fee
+	sentTxs      map[string]*sentTx
+	sentTxHashes []string
+	sentTxMutex  sync.Mutex
+
+	// the next sequences of the accounts which broadcast the txs by the client
+	sequences     map[string]*accountSequence
+	sequenceMutex sync.Mutex
+
+	// the light client verifying the headers and the rpc client querying the state with the proofs, which are nil if
+	// the verified queries are disabled
+	lightClient *light.Client
+	proofClient *rpchttp.HTTP
+	// the max number of the segments buffered by computing the hash roots, it is not bounded if it is zero
+	hashMaxBufferedSegments int
+	// the redundancy params overriding the storage params on chain, they are not overridden if it is nil
+	redundancyParams *types.ComputeHashOptions
+	// the context of the background goroutines, which is canceled by Close
+	closeCtx    context.Context
+	closeCancel context.CancelFunc
+	closeOnce   sync.Once
+	// the subscriptions of the chain events over the websocket connection, it is nil if UseWebSocketConn is not set
+	chainEvents *chainEvents
+	// the secondary gRPC connection of the hedging policy, it is nil if HedgingPolicy is not set
+	hedgingConn *grpc.ClientConn
 }
 
 // Option is a configuration struct used to provide optional parameters to the client constructor.
 type Option struct {
-	// GrpcDialOption is the list of gRPC dial options used to configure the connection to the blockchain node.
+	// GrpcAddress is the gRPC address of the blockchain node, e.g. "localhost:9090". The chain queries and txs are
+	// sent over gRPC if it is set, otherwise they are sent over the rpc endpoint. The blocks, the txs and the status
+	// of the node are always queried from the rpc endpoint.
+	GrpcAddress string
+	// GrpcDialOptions configure the gRPC connection to the blockchain node, e.g. grpc.WithKeepaliveParams,
+	// grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(n)), the interceptors and the load balancing config.
+	// They are applied after the defaults of the client, which are the transport credentials built from TLS, or the
+	// insecure credentials if TLS is not set, and the max received message size of types.DefaultGrpcMaxCallRecvMsgSize.
+	GrpcDialOptions []grpc.DialOption
+	// GrpcDialOption is applied after GrpcDialOptions.
+	//
+	// Deprecated: use GrpcDialOptions.
 	GrpcDialOption grpc.DialOption
-	// account used to set the default account of client
+	// account used to set the default account of client, the requests sent to SP are not signed if it is not set,
+	// which allows downloading the public-read objects anonymously
 	DefaultAccount *types.Account
 	// Secure is a flag that specifies whether the client should use HTTPS or not.
 	Secure bool
 	// Transport is the HTTP transport used to send requests to the storage provider endpoint.
 	Transport http.RoundTripper
+	// DialTimeout is the timeout of connecting to the storage provider, it is applied when Transport is not set
+	// or is an *http.Transport
+	DialTimeout time.Duration
+	// Proxy returns the proxy of the requests sent to the storage provider and the chain node, e.g.
+	// http.ProxyFromEnvironment to honour HTTP_PROXY, HTTPS_PROXY and NO_PROXY. It is applied when Transport is not
+	// set or is an *http.Transport. Without it, the storage provider requests sent by http.DefaultTransport follow the
+	// environment, and the chain requests are not proxied.
+	Proxy func(*http.Request) (*url.URL, error)
+	// TLS configures the custom CA bundle, the client certificate of mTLS and the verification of the server
+	// certificates of the connections to the storage provider and the chain node. It is applied when Transport is not
+	// set or is an *http.Transport.
+	TLS *types.TLSOptions
+	// ResponseHeaderTimeout is the timeout of waiting for the response headers of the storage provider, it is applied
+	// when Transport is not set or is an *http.Transport
+	ResponseHeaderTimeout time.Duration
+	// RequestTimeout is the default total timeout of each request sent to the storage provider including reading the
+	// response body, it can be overridden per call, e.g. by the Timeout of PutObjectOptions and GetObjectOptions.
+	// The requests are only bounded by the caller's context if it is not set.
+	RequestTimeout time.Duration
+	// ApprovalTimeout is the total timeout of requesting the approvals of creating and migrating the buckets and
+	// creating the objects from the primary SP, RequestTimeout is used if it is not set
+	ApprovalTimeout time.Duration
+	// UploadMaxTryTime is the max number of the attempts of sending an upload request which fails with a network error
+	// or a server error, types.MaxUploadTryTime is used if it is not set
+	UploadMaxTryTime int
+	// UploadBackOffDelay is the delay before re-sending the failed upload request, which doubles on every retry,
+	// types.UploadBackOffDelay is used if it is not set
+	UploadBackOffDelay time.Duration
+	// ApprovalCacheTTL enables caching the createBucket approvals signed by the primary SP for the duration, keyed by
+	// the hash of the unsigned msg, so the retries and the dry runs of the same bucket do not request the approval
+	// again. It should be well below the expiry of the approvals, the approvals are not cached if it is not set.
+	ApprovalCacheTTL time.Duration
+	// UserAgentSuffix is appended to the default user agent of the requests sent to the chain node and the storage provider
+	UserAgentSuffix string
+	// AppID identifies the application in the X-Gnfd-App-ID header of the requests sent to the chain node and the
+	// storage provider, which is used by the operators for traffic attribution
+	AppID string
+	// TransportMiddlewares wrap the transport used to send requests to the storage provider endpoint, e.g. to add
+	// custom headers, audit the signed requests or inject faults. The first middleware is the outermost one.
+	TransportMiddlewares []TransportMiddleware
 	// Host is the target sp server hostname
 	Host string
 	// OffChainAuthOption consists of a EdDSA private key and the domain where the EdDSA keys will be registered for.
 	// This property should not be set in most cases unless you want to use go-sdk to test if the SP support off-chain-auth feature.
 	// Once this property is set, the request will be signed in "off-chain-auth" way rather than v1
 	OffChainAuthOption *OffChainAuthOption
-	// UseWebSocketConn specifies that connection to Chain is via websocket
+	// UseWebSocketConn specifies that connection to Chain is via websocket. The client also subscribes the events of
+	// the chain over the websocket connection, e.g. to refresh the cached SP list when an SP is created, edited or its
+	// status is updated.
 	UseWebSocketConn bool
 	// ExpireSeconds indicates the number of seconds after which the authentication of the request sent to the SP will become invalid，the default value is 1000
 	ExpireSeconds uint64
+	// SPHealthCheckInterval indicates the interval of probing the in-service SPs in background.
+	// The health checker is disabled if it is not set.
+	SPHealthCheckInterval time.Duration
+	// SPRefreshInterval indicates the interval of refreshing the cached SP list from chain in background, which is the
+	// fallback of the refreshes on the SP events if UseWebSocketConn is set. The SP list is only refreshed on demand,
+	// e.g. when an unknown SP is looked up, if neither is set.
+	SPRefreshInterval time.Duration
+	// InterfaceRegistrars are used to register custom msg types and interfaces into the codec of the client,
+	// e.g. the RegisterInterfaces function of a custom module.
+	InterfaceRegistrars []func(registry codectypes.InterfaceRegistry)
+	// DeleteProtectionPatterns is the list of shell patterns (see path.Match) of the resources which are refused to be
+	// deleted unless the Force option is set. A bucket is matched by its name and an object by "bucketName/objectName",
+	// e.g. "prod-*" protects the buckets prefixed with "prod-" and "logs/2023/*" protects the objects under "2023/" of bucket "logs".
+	DeleteProtectionPatterns []string
+	// ParamsCacheTTL indicates how long the storage params queried from chain are cached, which are used by every
+	// CreateObject and PutObject. The params are queried every time if it is not set.
+	ParamsCacheTTL time.Duration
+	// ObjectCacheDir enables the read-through disk cache of GetObject, the payloads of the sealed objects downloaded
+	// entirely are cached in the directory, so repeated reads of the hot objects don't consume the read quota.
+	ObjectCacheDir string
+	// ObjectCacheMaxSize is the max total size in bytes of the object disk cache, the least recently used payloads
+	// are evicted beyond it. types.DefaultObjectCacheMaxSize is used if it is not set.
+	ObjectCacheMaxSize int64
+	// Clock provides the time of the date and the expiry of the signed requests and authorizations, the system time
+	// is used if it is not set. A types.FixedClock can be set in tests to produce deterministic signatures and headers.
+	Clock types.Clock
+	// FeePolicy decides the gas price of the transactions sent by the client whose fee is not set in TxOption, e.g. a
+	// surge multiplier with a cap to bound the costs centrally. The gas price suggested by the chain is used if it is not set.
+	FeePolicy *types.FeePolicy
+	// WaitTxMaxBlocks is the number of the blocks after which WaitForTx checks whether the tx is still pending in the
+	// mempool and fails with types.TxNotIncludedError if not. types.DefaultWaitTxMaxBlocks is used if it is not set,
+	// and the check is disabled if it is negative.
+	WaitTxMaxBlocks int64
+	// ApprovalStore persists the createObject msgs signed by the primary SP before they are broadcast, and deletes
+	// them after the payloads are uploaded. A worker restarted after a crash calls CreateObject with the same payload
+	// to reuse the stored approval or the broadcast tx, and then uploads the payload by PutObject. The unfinished
+	// objects are listed by ListPendingObjects. A types.FileApprovalStore can be used.
+	ApprovalStore types.ApprovalStore
+	// PreflightChecks verifies the sender of every tx exists on chain and the fee payer has enough balance for the
+	// fee before broadcasting it, and fails with types.ErrorAccountNotFound or a types.InsufficientFundsError carrying
+	// the shortfall instead of the errors of the chain. Only the fee is checked, not the amounts spent by the msgs,
+	// and it costs two more queries per tx.
+	PreflightChecks bool
+	// SPEndpointResolver overrides the on-chain endpoints of the storage providers, e.g. to reach the SPs inside a
+	// private network by their internal endpoints. It is consulted whenever the SP list is refreshed from chain, and
+	// a types.StaticSPEndpointResolver can be used for a fixed mapping.
+	SPEndpointResolver types.SPEndpointResolver
+	// ChainRateLimit limits the requests sent to each chain endpoint, i.e. the gRPC address and the rpc endpoint, by a
+	// token bucket of its own, so that the bulk jobs are throttled by the client instead of being banned by the public
+	// endpoints. The requests wait for a token until their context is done. The websocket connection is not limited.
+	ChainRateLimit *types.RateLimitOptions
+	// HedgingPolicy hedges the latency-sensitive chain queries, e.g. HeadObject, across the gRPC address and the
+	// secondary gRPC address of the policy, it requires GrpcAddress to be set
+	HedgingPolicy *types.HedgingPolicy
+	// SyncGuard refuses the requests sent to the chain node with types.ErrorNodeLagging if the node is catching up or
+	// lagging more than the max block lag, which is estimated by GetSyncStatus. It applies to the gRPC address and the
+	// rpc endpoint, not the websocket connection or the secondary endpoint of the hedging policy.
+	SyncGuard *types.SyncGuardOptions
+	// LightClient enables the VerifiedQuery APIs, which verify the proofs of the state queried from the rpc endpoint
+	// against the headers verified by a light client from the trusted header of the options. The light client is
+	// initialized on constructing the client, which fetches the trusted header from the rpc endpoint.
+	LightClient *types.LightClientOptions
+	// HashMaxBufferedSegments bounds the memory of computing the hash roots of the payloads, e.g. by CreateObject and
+	// ComputeHashRoots, by streaming the segments through at most the number of the fixed-size buffers reused across
+	// the segments, and hashing them by at most the number of the workers. The max memory is about
+	// types.HashMemoryBound of the redundancy params, e.g. 40MB per buffered segment for the segment size of 16MB
+	// with 4 data shards and 2 parity shards. Without it, the parallel hashing of ComputeHashRoots may buffer up to a
+	// hundred segments.
+	HashMaxBufferedSegments int
+	// RedundancyParams overrides the segment size and the shards of the storage params on chain for computing the hash
+	// roots, e.g. for the private deployments with custom params. They are validated against the chain params whenever
+	// the params can be queried, and allow hashing the payloads offline if all of them are set.
+	RedundancyParams *types.ComputeHashOptions
+}
+
+// TransportMiddleware wraps the http.RoundTripper to intercept the requests sent to the storage provider
+type TransportMiddleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is an adapter to allow the use of ordinary functions as http.RoundTripper
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// telemetryHeaderMiddleware sets the user agent and the app id headers of the requests
+func telemetryHeaderMiddleware(userAgent, appID string) TransportMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set(types.HTTPHeaderUserAgent, userAgent)
+			if appID != "" {
+				req.Header.Set(types.HTTPHeaderAppID, appID)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// applyTransportTimeouts returns a copy of the transport with the dial and response header timeouts,
+// the transport is returned as it is if no timeout is set or it is not an *http.Transport
+func applyTransportTimeouts(transport http.RoundTripper, dialTimeout, responseHeaderTimeout time.Duration) http.RoundTripper {
+	if dialTimeout == 0 && responseHeaderTimeout == 0 {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return transport
+	}
+	httpTransport = httpTransport.Clone()
+	if dialTimeout > 0 {
+		dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}
+		httpTransport.DialContext = dialer.DialContext
+	}
+	if responseHeaderTimeout > 0 {
+		httpTransport.ResponseHeaderTimeout = responseHeaderTimeout
+	}
+	return httpTransport
+}
+
+// applyTransportNetwork returns a copy of the transport with the proxy and the TLS config, the transport is returned
+// as it is if neither is set or it is not an *http.Transport
+func applyTransportNetwork(transport http.RoundTripper, proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config) http.RoundTripper {
+	if proxy == nil && tlsConfig == nil {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return transport
+	}
+	httpTransport = httpTransport.Clone()
+	if proxy != nil {
+		httpTransport.Proxy = proxy
+	}
+	if tlsConfig != nil {
+		httpTransport.TLSClientConfig = tlsConfig.Clone()
+	}
+	return httpTransport
+}
+
+// grpcDialOptions returns the default dial options of the gRPC connection followed by the ones of the option, the
+// calls are limited by the limiter if it is not nil
+func grpcDialOptions(option Option, tlsConfig *tls.Config, limiter *utils.RateLimiter) []grpc.DialOption {
+	transportCredentials := insecure.NewCredentials()
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(types.DefaultGrpcMaxCallRecvMsgSize)),
+	}
+	if limiter != nil {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(rateLimitUnaryInterceptor(limiter)))
+	}
+	dialOptions = append(dialOptions, option.GrpcDialOptions...)
+	if option.GrpcDialOption != nil {
+		dialOptions = append(dialOptions, option.GrpcDialOption)
+	}
+	return dialOptions
+}
+
+// hedgingGrpcDialOptions dials the secondary gRPC address of the hedging policy with the same options as the primary
+// one, and its own rate limiter if the rate limit is set, and returns the dial options hedging the primary connection
+// and the secondary connection, which should be closed with the client
+func hedgingGrpcDialOptions(option Option, tlsConfig *tls.Config) ([]grpc.DialOption, *grpc.ClientConn, error) {
+	if option.HedgingPolicy.GrpcAddress == "" {
+		return nil, nil, errors.New("the gRPC address of the hedging policy is not set")
+	}
+	if option.HedgingPolicy.Delay < 0 {
+		return nil, nil, fmt.Errorf("the delay of the hedging policy should not be negative, got %s", option.HedgingPolicy.Delay)
+	}
+	var limiter *utils.RateLimiter
+	if option.ChainRateLimit != nil {
+		var err error
+		if limiter, err = utils.NewRateLimiter(option.ChainRateLimit.QPS, option.ChainRateLimit.Burst); err != nil {
+			return nil, nil, err
+		}
+	}
+	secondary, err := grpc.Dial(option.HedgingPolicy.GrpcAddress, grpcDialOptions(option, tlsConfig, limiter)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the policy is copied so that it is not changed by the caller afterwards
+	policy := *option.HedgingPolicy
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(hedgingUnaryInterceptor(&policy, secondary))}, secondary, nil
+}
+
+// newChainHTTPClient returns the http client of the chain rpc with the proxy and the TLS config. The default client
+// of the rpc dials the node directly, so it is replaced by a standard transport unless the node is a unix socket.
+func newChainHTTPClient(remote string, proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config) (*http.Client, error) {
+	if (proxy == nil && tlsConfig == nil) || strings.HasPrefix(remote, "unix://") {
+		return jsonrpcclient.DefaultHTTPClient(remote)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// set to true to prevent GZIP-bomb DoS attacks as the default client of the rpc
+	transport.DisableCompression = true
+	transport.Proxy = proxy
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.Clone()
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// chainTransportMiddlewares wraps the transport with the middlewares, http.DefaultTransport is used if transport is nil
+func chainTransportMiddlewares(transport http.RoundTripper, middlewares []TransportMiddleware) http.RoundTripper {
+	if len(middlewares) == 0 {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		transport = middlewares[i](transport)
+	}
+	return transport
 }
 
 // OffChainAuthOption consists of a EdDSA private key and the domain where the EdDSA keys will be registered for.
@@ -114,6 +508,19 @@ type OffChainAuthOption struct {
 	ShouldRegisterPubKey bool
 }
 
+// NewGnfdClientFromPreset instantiates the client of a public network by the name of its preset, e.g.
+// types.NetworkTestnet, the GrpcAddress of the preset is used if the option does not set one
+func NewGnfdClientFromPreset(presetName string, option Option) (Client, error) {
+	preset, err := types.GetNetworkPreset(presetName)
+	if err != nil {
+		return nil, err
+	}
+	if option.GrpcAddress == "" {
+		option.GrpcAddress = preset.GrpcAddress
+	}
+	return New(preset.ChainID, preset.RpcAddress, option)
+}
+
 // New - instantiate greenfield chain with chain info, account info and options.
 // endpoint indicates the rpc address of greenfield
 func New(chainID string, endpoint string, option Option) (Client, error) {
@@ -124,48 +531,185 @@ func New(chainID string, endpoint string, option Option) (Client, error) {
 		cc  *sdkclient.GreenfieldClient
 		err error
 	)
+	userAgent := types.UserAgent
+	if option.UserAgentSuffix != "" {
+		userAgent += " " + option.UserAgentSuffix
+	}
+	var tlsConfig *tls.Config
+	if option.TLS != nil {
+		if tlsConfig, err = option.TLS.Config(); err != nil {
+			return nil, err
+		}
+	}
+	var grpcLimiter, rpcLimiter *utils.RateLimiter
+	if option.ChainRateLimit != nil {
+		if grpcLimiter, err = utils.NewRateLimiter(option.ChainRateLimit.QPS, option.ChainRateLimit.Burst); err != nil {
+			return nil, err
+		}
+		if rpcLimiter, err = utils.NewRateLimiter(option.ChainRateLimit.QPS, option.ChainRateLimit.Burst); err != nil {
+			return nil, err
+		}
+	}
+	var guard *syncGuard
+	if option.SyncGuard != nil {
+		if guard, err = newSyncGuard(*option.SyncGuard); err != nil {
+			return nil, err
+		}
+	}
+	for _, pattern := range option.DeleteProtectionPatterns {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid delete protection pattern %s: %v", pattern, err)
+		}
+	}
+
+	if option.RedundancyParams != nil {
+		if err = option.RedundancyParams.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if option.ExpireSeconds > httplib.MaxExpiryAgeInSec {
+		return nil, errors.New("the configured expire time exceeds max expire time")
+	}
+
+	var (
+		chainOpts   []sdkclient.GreenfieldClientOption
+		hedgingConn *grpc.ClientConn
+	)
 	if option.UseWebSocketConn {
-		cc, err = sdkclient.NewGreenfieldClient(endpoint, chainID, sdkclient.WithWebSocketClient())
+		chainOpts = append(chainOpts, sdkclient.WithWebSocketClient())
+	}
+	if option.GrpcAddress != "" {
+		dialOptions := grpcDialOptions(option, tlsConfig, grpcLimiter)
+		if guard != nil {
+			dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(guard.unaryInterceptor()))
+		}
+		if option.HedgingPolicy != nil {
+			var hedgingDialOptions []grpc.DialOption
+			if hedgingDialOptions, hedgingConn, err = hedgingGrpcDialOptions(option, tlsConfig); err != nil {
+				return nil, err
+			}
+			dialOptions = append(dialOptions, hedgingDialOptions...)
+		}
+		chainOpts = append(chainOpts, sdkclient.WithGrpcConnectionAndDialOption(option.GrpcAddress, dialOptions...))
+	} else if option.HedgingPolicy != nil {
+		return nil, errors.New("the hedging policy requires the gRPC address of the chain node")
+	}
+	if option.UserAgentSuffix != "" || option.AppID != "" || option.Proxy != nil || tlsConfig != nil || rpcLimiter != nil || guard != nil {
+		cc, err = sdkclient.NewCustomGreenfieldClient(endpoint, chainID, func(remote string) (*http.Client, error) {
+			httpClient, err := newChainHTTPClient(remote, option.Proxy, tlsConfig)
+			if err != nil {
+				return nil, err
+			}
+			// attach the telemetry headers to the requests sent to the chain node
+			if option.UserAgentSuffix != "" || option.AppID != "" {
+				httpClient.Transport = telemetryHeaderMiddleware(userAgent, option.AppID)(httpClient.Transport)
+			}
+			if rpcLimiter != nil {
+				httpClient.Transport = rateLimitMiddleware(rpcLimiter)(httpClient.Transport)
+			}
+			if guard != nil {
+				httpClient.Transport = guard.middleware()(httpClient.Transport)
+			}
+			return httpClient, nil
+		}, chainOpts...)
 	} else {
-		cc, err = sdkclient.NewGreenfieldClient(endpoint, chainID)
+		cc, err = sdkclient.NewGreenfieldClient(endpoint, chainID, chainOpts...)
 	}
 	if err != nil {
+		if hedgingConn != nil {
+			hedgingConn.Close()
+		}
 		return nil, err
 	}
 	if option.DefaultAccount != nil {
 		cc.SetKeyManager(option.DefaultAccount.GetKeyManager())
 	}
 
-	if option.ExpireSeconds > httplib.MaxExpiryAgeInSec {
-		return nil, errors.New("the configured expire time exceeds max expire time")
+	for _, registerFn := range option.InterfaceRegistrars {
+		registerFn(cc.GetCodec().InterfaceRegistry())
 	}
 
+	transport := applyTransportTimeouts(option.Transport, option.DialTimeout, option.ResponseHeaderTimeout)
+	transport = applyTransportNetwork(transport, option.Proxy, tlsConfig)
+
 	c := client{
 		chainClient:      cc,
-		httpClient:       &http.Client{Transport: option.Transport},
-		userAgent:        types.UserAgent,
+		httpClient:       &http.Client{Transport: chainTransportMiddlewares(transport, option.TransportMiddlewares)},
+		userAgent:        userAgent,
+		appID:            option.AppID,
 		defaultAccount:   option.DefaultAccount, // it allows to be nil
 		secure:           option.Secure,
 		host:             option.Host,
 		storageProviders: make(map[uint32]*types.StorageProvider),
 		useWebsocketConn: option.UseWebSocketConn,
 		expireSeconds:    option.ExpireSeconds,
+		spHealth:         make(map[uint32]*types.SPHealth),
+
+		deleteProtectionPatterns: option.DeleteProtectionPatterns,
+		paramsCacheTTL:           option.ParamsCacheTTL,
+		requestTimeout:           option.RequestTimeout,
+		approvalTimeout:          option.ApprovalTimeout,
+		uploadMaxTryTime:         option.UploadMaxTryTime,
+		uploadBackOffDelay:       option.UploadBackOffDelay,
+		approvalCacheTTL:         option.ApprovalCacheTTL,
+		clock:                    option.Clock,
+		feePolicy:                option.FeePolicy,
+		waitTxMaxBlocks:          option.WaitTxMaxBlocks,
+		approvalStore:            option.ApprovalStore,
+		spEndpointResolver:       option.SPEndpointResolver,
+		preflightChecks:          option.PreflightChecks,
+		hashMaxBufferedSegments:  option.HashMaxBufferedSegments,
+		redundancyParams:         option.RedundancyParams,
+		hedgingConn:              hedgingConn,
+	}
+	c.closeCtx, c.closeCancel = context.WithCancel(context.Background())
+
+	if guard != nil {
+		guard.client = &c
+	}
+	if option.UseWebSocketConn {
+		if c.chainEvents, err = newChainEvents(c.closeCtx, endpoint, option.Proxy, tlsConfig); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if option.LightClient != nil {
+		if c.lightClient, c.proofClient, err = newLightClient(context.Background(), chainID, endpoint, *option.LightClient, option.Proxy, tlsConfig); err != nil {
+			c.Close()
+			return nil, err
+		}
 	}
 
 	// fetch sp endpoints info from chain
 	err = c.refreshStorageProviders(context.Background())
 
 	if err != nil {
+		c.Close()
 		return nil, err
 	}
+	if option.ObjectCacheDir != "" {
+		if c.objectCache, err = newObjectDiskCache(option.ObjectCacheDir, option.ObjectCacheMaxSize); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if option.SPRefreshInterval > 0 || c.chainEvents != nil {
+		c.startSPRefresh(option.SPRefreshInterval)
+	}
+	if option.SPHealthCheckInterval > 0 {
+		c.startSPHealthCheck(option.SPHealthCheckInterval)
+	}
+
 	// register off-chain-auth pubkey to all sps
 	if option.OffChainAuthOption != nil {
 		if option.OffChainAuthOption.Seed == "" || option.OffChainAuthOption.Domain == "" {
+			c.Close()
 			return nil, errors.New("seed and domain can't be empty in OffChainAuthOption")
 		}
 		c.offChainAuthOption = option.OffChainAuthOption
 		if option.OffChainAuthOption.ShouldRegisterPubKey {
-			for _, sp := range c.storageProviders {
+			for _, sp := range c.getStorageProviders() {
 				registerResult, err := c.RegisterEDDSAPublicKey(sp.OperatorAddress.String(), sp.EndPoint.Scheme+"://"+sp.EndPoint.Host)
 				if err != nil {
 					log.Error().Msg(fmt.Sprintf("Fail to RegisterEDDSAPublicKey for sp : %s", sp.EndPoint))
@@ -178,18 +722,83 @@ func New(chainID string, endpoint string, option Option) (Client, error) {
 	return &c, nil
 }
 
+// NewReadOnlyGnfdClient returns a client which only queries the chain and the storage providers and downloads
+// objects, no account or key manager is required. The requests sent to the storage providers are not signed,
+// so only the public resources can be downloaded. The methods which broadcast txs, sign requests, or upload
+// objects to the storage providers return types.ErrorReadOnlyClient.
+func NewReadOnlyGnfdClient(chainID string, endpoint string, option Option) (Client, error) {
+	if option.DefaultAccount != nil || option.OffChainAuthOption != nil {
+		return nil, errors.New("the read-only client can not be constructed with an account or off-chain-auth option")
+	}
+	c, err := New(chainID, endpoint, option)
+	if err != nil {
+		return nil, err
+	}
+	c.(*client).readOnly = true
+	return c, nil
+}
+
+// checkDeleteProtection returns ErrorDeleteProtected if the resource matches any delete protection pattern
+// and the deletion is not forced
+func (c *client) checkDeleteProtection(resource string, force bool) error {
+	if force {
+		return nil
+	}
+	for _, pattern := range c.deleteProtectionPatterns {
+		if matched, _ := path.Match(pattern, resource); matched {
+			return fmt.Errorf("%w: %s matches pattern %s, set Force to delete it", types.ErrorDeleteProtected, resource, pattern)
+		}
+	}
+	return nil
+}
+
 // EnableTrace support trace error info the request and the response
 func (c *client) EnableTrace(output io.Writer, onlyTraceErr bool) {
 	if output == nil {
 		output = os.Stdout
 	}
 
+	c.traceMutex.Lock()
+	defer c.traceMutex.Unlock()
 	c.onlyTraceError = onlyTraceErr
 
 	c.traceOutput = output
 	c.isTraceEnabled = true
 }
 
+// Close stops the background goroutines of the client, and closes the websocket connection of the chain events and
+// the secondary gRPC connection of the hedging policy
+func (c *client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.closeCancel()
+		if c.chainEvents != nil {
+			err = c.chainEvents.close()
+		}
+		if c.hedgingConn != nil {
+			if closeErr := c.hedgingConn.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+// traceConfig returns whether the requests are traced and whether only the failed requests are traced
+func (c *client) traceConfig() (bool, bool) {
+	c.traceMutex.Lock()
+	defer c.traceMutex.Unlock()
+	return c.isTraceEnabled, c.onlyTraceError
+}
+
+// now returns the current time of the client clock
+func (c *client) now() time.Time {
+	if c.clock == nil {
+		return types.SystemClock{}.Now()
+	}
+	return c.clock.Now()
+}
+
 func (c *client) getSPUrlByBucket(bucketName string) (*url.URL, error) {
 	sp, err := c.pickStorageProviderByBucket(bucketName)
 	if err != nil {
@@ -210,74 +819,55 @@ func (c *client) pickStorageProviderByBucket(bucketName string) (*types.StorageP
 		return nil, err
 	}
 
-	sp, ok := c.storageProviders[familyResp.GlobalVirtualGroupFamily.PrimarySpId]
-	if ok {
-		return sp, nil
-	}
-	// refresh the meta from blockchain
-	err = c.refreshStorageProviders(ctx)
+	sp, err := c.getStorageProviderByID(ctx, familyResp.GlobalVirtualGroupFamily.PrimarySpId)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("the storage provider %d not exists on chain", familyResp.GlobalVirtualGroupFamily.PrimarySpId)
 	}
-
-	sp, ok = c.storageProviders[familyResp.GlobalVirtualGroupFamily.PrimarySpId]
-	if ok {
-		return sp, nil
-	}
-	return nil, fmt.Errorf("the storage provider %d not exists on chain", familyResp.GlobalVirtualGroupFamily.PrimarySpId)
+	return sp, nil
 }
 
 // getSPUrlByID route url of the sp from sp id
-func (c *client) getSPUrlByID(id uint32) (*url.URL, error) {
-	sp, ok := c.storageProviders[id]
-	if ok {
-		return sp.EndPoint, nil
+func (c *client) getSPUrlByID(ctx context.Context, id uint32) (*url.URL, error) {
+	sp, err := c.getStorageProviderByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("the SP endpoint %d not exists on chain", id)
 	}
-
-	return nil, fmt.Errorf("the SP endpoint %d not exists on chain", id)
+	return sp.EndPoint, nil
 }
 
 // getSPUrlByAddr route url of the sp from sp address
-func (c *client) getSPUrlByAddr(address string) (*url.URL, error) {
+func (c *client) getSPUrlByAddr(ctx context.Context, address string) (*url.URL, error) {
 	acc, err := sdk.AccAddressFromHexUnsafe(address)
 	if err != nil {
 		return nil, err
 	}
-	for _, sp := range c.storageProviders {
-		if sp.OperatorAddress.Equals(acc) {
-			return sp.EndPoint, nil
-		}
+	match := func(sp *types.StorageProvider) bool {
+		return sp.OperatorAddress.Equals(acc)
 	}
-
-	return nil, fmt.Errorf("the SP endpoint %s not exists on chain", address)
-}
-
-// getInServiceSP return the first SP endpoint which is in service in SP list
-func (c *client) getInServiceSP() (*url.URL, error) {
-	ctx := context.Background()
-	spList, err := c.ListStorageProviders(ctx, true)
+	sp, err := c.findStorageProvider(ctx, match)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("the SP endpoint %s not exists on chain", address)
 	}
+	return sp.EndPoint, nil
+}
 
+// getInServiceSP return the SP endpoint which is in service in the cached SP list, the available SP with the lowest
+// latency is preferred if the SP health checker is enabled
+func (c *client) getInServiceSP(ctx context.Context) (*url.URL, error) {
+	spList := c.getInServiceStorageProviders()
 	if len(spList) == 0 {
-		return nil, errors.New("fail to get SP endpoint")
-	}
-
-	var useHttps bool
-	SPEndpoint := spList[0].Endpoint
-	if strings.Contains(SPEndpoint, "https") {
-		useHttps = true
-	} else {
-		useHttps = c.secure
+		// refresh the meta from blockchain
+		if err := c.refreshStorageProviders(ctx); err != nil {
+			return nil, err
+		}
+		spList = c.getInServiceStorageProviders()
 	}
 
-	urlInfo, urlErr := utils.GetEndpointURL(spList[0].Endpoint, useHttps)
-	if urlErr != nil {
-		return nil, urlErr
+	if len(spList) == 0 {
+		return nil, errors.New("fail to get SP endpoint")
 	}
 
-	return urlInfo, nil
+	return c.selectHealthySP(spList).EndPoint, nil
 }
 
 // requestMeta - contains the metadata to construct the http request.
@@ -294,6 +884,7 @@ type requestMeta struct {
 	contentSHA256    string // hex encoded sha256sum
 	pieceInfo        types.QueryPieceInfo
 	userAddress      string
+	userMetadata     map[string]string // user-defined metadata set as X-Gnfd-Meta-* headers
 }
 
 // SendOptions -  options to use to send the http message
@@ -303,6 +894,8 @@ type sendOptions struct {
 	disableCloseBody bool        // indicate whether to disable automatic calls to resp.Body.Close()
 	txnHash          string      // the transaction hash info
 	isAdminApi       bool        // indicate if it is an admin api request
+	// the total timeout of the request, the RequestTimeout of the client is used if it is not set
+	timeout time.Duration
 }
 
 // downloadSegmentHook is hook for test
@@ -366,6 +959,13 @@ func (c *client) newRequest(ctx context.Context, method string, meta requestMeta
 	// set content length
 	req.ContentLength = meta.contentLength
 
+	// set request id header
+	requestID := types.RequestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = types.NewRequestID()
+	}
+	req.Header.Set(types.HTTPHeaderRequestID, requestID)
+
 	// set txn hash header
 	if txnHash != "" {
 		req.Header.Set(types.HTTPHeaderTransactionHash, txnHash)
@@ -421,8 +1021,12 @@ func (c *client) newRequest(ctx context.Context, method string, meta requestMeta
 		req.Header.Set(types.HTTPHeaderUserAddress, meta.userAddress)
 	}
 
+	for key, value := range meta.userMetadata {
+		req.Header.Set(types.HTTPHeaderUserMetaPrefix+key, value)
+	}
+
 	// set date header
-	stNow := time.Now().UTC()
+	stNow := c.now().UTC()
 	req.Header.Set(types.HTTPHeaderDate, stNow.Format(types.Iso8601DateFormatSecond))
 
 	// set expiry for authorization
@@ -435,6 +1039,19 @@ func (c *client) newRequest(ctx context.Context, method string, meta requestMeta
 
 	// set user-agent
 	req.Header.Set(types.HTTPHeaderUserAgent, c.userAgent)
+	if c.appID != "" {
+		req.Header.Set(types.HTTPHeaderAppID, c.appID)
+	}
+
+	// the read-only client sends the anonymous queries and downloads, the uploads of the object payload and the
+	// approvals of the txs are refused
+	if c.readOnly {
+		if method == http.MethodPut || method == http.MethodDelete || (method == http.MethodPost && meta.objectName != "") ||
+			meta.txnMsg != "" {
+			return nil, types.ErrorReadOnlyClient
+		}
+		return
+	}
 
 	// sign the total http request info when auth type v1
 	err = c.signRequest(req)
@@ -453,8 +1070,12 @@ func (c *client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 		defer cancel()
 	}
 	req = req.WithContext(ctx)
+	dumpOutput, dumpOpts := c.requestDumpConfig()
 
 	resp, err := c.httpClient.Do(req)
+	if dumpOutput != nil {
+		c.dumpSignedRequest(dumpOutput, dumpOpts, req, resp, err)
+	}
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -484,7 +1105,7 @@ func (c *client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 	err = types.ConstructErrResponse(resp, meta.bucketName, meta.objectName)
 	if err != nil {
 		// dump error msg
-		if c.isTraceEnabled {
+		if isTraceEnabled, _ := c.traceConfig(); isTraceEnabled {
 			c.dumpSPMsg(req, resp)
 		}
 		if !closeBody {
@@ -494,7 +1115,7 @@ func (c *client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 	}
 
 	// dump msg
-	if c.isTraceEnabled && !c.onlyTraceError {
+	if isTraceEnabled, onlyTraceError := c.traceConfig(); isTraceEnabled && !onlyTraceError {
 		c.dumpSPMsg(req, resp)
 	}
 
@@ -503,6 +1124,23 @@ func (c *client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 
 // sendReq sends the message via REST and handles the response
 func (c *client) sendReq(ctx context.Context, metadata requestMeta, opt *sendOptions, endpoint *url.URL) (res *http.Response, err error) {
+	timeout := opt.timeout
+	if timeout == 0 {
+		timeout = c.requestTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer func() {
+			// the timeout covers reading the body which is closed by the caller
+			if err == nil && opt.disableCloseBody {
+				res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+				return
+			}
+			cancel()
+		}()
+	}
+
 	req, err := c.newRequest(ctx, opt.method, metadata, opt.body, opt.txnHash, opt.isAdminApi, endpoint)
 	if err != nil {
 		return nil, err
@@ -510,12 +1148,23 @@ func (c *client) sendReq(ctx context.Context, metadata requestMeta, opt *sendOpt
 
 	resp, err := c.doAPI(ctx, req, metadata, !opt.disableCloseBody)
 	if err != nil {
-		log.Error().Msg(fmt.Sprintf("do API error, url: %s, err: %s", req.URL.String(), err))
+		log.Error().Str("request_id", req.Header.Get(types.HTTPHeaderRequestID)).Msg(fmt.Sprintf("do API error, url: %s, err: %s", req.URL.String(), err))
 		return nil, err
 	}
 	return resp, nil
 }
 
+// cancelOnCloseBody cancels the context of the request when the response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 func (c *client) SplitPartInfo(objectSize int64, configuredPartSize uint64) (totalPartsCount int, partSize int64, lastPartSize int64, err error) {
 	partSizeFlt := float64(configuredPartSize)
 	// Total parts count.
@@ -582,9 +1231,17 @@ func (c *client) generateURL(bucketName string, objectName string, relativePath
 
 // signRequest signs the request and set authorization before send to server
 func (c *client) signRequest(req *http.Request) error {
+	if c.readOnly {
+		return types.ErrorReadOnlyClient
+	}
+	// the client without key sends the anonymous request, which is only allowed to access the public resources
+	account := c.loadDefaultAccount()
+	if account == nil {
+		return nil
+	}
 	// use offChainAuth if OffChainAuthOption is set
 	if c.offChainAuthOption != nil {
-		req.Header.Set("X-Gnfd-User-Address", c.defaultAccount.GetAddress().String())
+		req.Header.Set("X-Gnfd-User-Address", account.GetAddress().String())
 		req.Header.Set("X-Gnfd-App-Domain", c.offChainAuthOption.Domain)
 		unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req)
 		authStr := c.OffChainAuthSign(unsignedMsg)
@@ -596,7 +1253,7 @@ func (c *client) signRequest(req *http.Request) error {
 	unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req)
 
 	// sign the request header info, generate the signature
-	signature, err := c.MustGetDefaultAccount().Sign(unsignedMsg)
+	signature, err := account.Sign(unsignedMsg)
 	if err != nil {
 		return err
 	}
@@ -630,6 +1287,9 @@ func (c *client) isVirtualHostStyleUrl(url url.URL, bucketName string) bool {
 }
 
 func (c *client) dumpSPMsg(req *http.Request, resp *http.Response) {
+	// the dumps of the concurrent requests are not interleaved
+	c.traceMutex.Lock()
+	defer c.traceMutex.Unlock()
 	var err error
 	defer func() {
 		if err != nil {
@@ -700,7 +1360,7 @@ func (c *client) sendPutPolicyTxn(ctx context.Context, msg *storageTypes.MsgPutP
 		return "", err
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, txOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, txOpts)
 	if err != nil {
 		return "", err
 	}
@@ -716,7 +1376,7 @@ func (c *client) sendDelPolicyTxn(ctx context.Context, operator sdk.AccAddress,
 		return "", err
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{delPolicyMsg}, txOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{delPolicyMsg}, txOpts)
 	if err != nil {
 		return "", err
 	}
@@ -724,12 +1384,132 @@ func (c *client) sendDelPolicyTxn(ctx context.Context, operator sdk.AccAddress,
 	return resp.TxResponse.TxHash, err
 }
 
+// broadcastTx broadcasts the msgs with the gas price decided by the fee policy of the client, unless the fee is set
+// in txOpt by NoSimulate. The sequence is allocated by the client unless it is set in txOpt, and kept with the tx for
+// the resubmission. The block mode is not supported by the nodes any more, so it is emulated by broadcasting in sync
+// mode and waiting for the tx to be committed.
+func (c *client) broadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *gnfdSdkTypes.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	explicitNonce := txOpt != nil && txOpt.Nonce != 0
+	return c.broadcastTxWithNonce(ctx, msgs, txOpt, explicitNonce, opts...)
+}
+
+// broadcastTxWithNonce broadcasts the msgs like broadcastTx, the sequence of txOpt.Nonce is used as it is if
+// explicitNonce is set, even if it is zero, e.g. the resubmission of the first tx of an account
+func (c *client) broadcastTxWithNonce(ctx context.Context, msgs []sdk.Msg, txOpt *gnfdSdkTypes.TxOption, explicitNonce bool,
+	opts ...grpc.CallOption,
+) (*tx.BroadcastTxResponse, error) {
+	var sendOpt gnfdSdkTypes.TxOption
+	if txOpt != nil {
+		sendOpt = *txOpt
+	}
+	// the signer is fixed before the broadcast, so the tx is not affected by a concurrent SetDefaultAccount
+	sendOpt, err := c.withSigner(sendOpt)
+	if err != nil {
+		return nil, err
+	}
+	blockMode := sendOpt.Mode != nil && *sendOpt.Mode == tx.BroadcastMode_BROADCAST_MODE_BLOCK //nolint:staticcheck
+	if blockMode {
+		syncMode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+		sendOpt.Mode = &syncMode
+	}
+
+	if c.preflightChecks {
+		if err = c.preflightAccount(ctx, &sendOpt); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *tx.BroadcastTxResponse
+	if explicitNonce {
+		resp, err = c.sendTx(ctx, msgs, &sendOpt, opts...)
+	} else {
+		resp, err = c.sendTxWithSequence(ctx, msgs, &sendOpt, opts...)
+	}
+	if err != nil || resp.TxResponse.Code != 0 {
+		return resp, err
+	}
+	c.rememberSentTx(resp.TxResponse.TxHash, msgs, sendOpt)
+	if !blockMode {
+		return resp, nil
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	txnResponse, err := c.WaitForTx(ctxTimeout, resp.TxResponse.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("the transaction %s has been submitted, please check it later: %w", resp.TxResponse.TxHash, err)
+	}
+	return &tx.BroadcastTxResponse{TxResponse: sdk.NewResponseResultTx(txnResponse, nil, "")}, nil
+}
+
+// sendTx simulates the fee of the msgs unless it is set in txOpt by NoSimulate, and broadcasts them
+func (c *client) sendTx(ctx context.Context, msgs []sdk.Msg, txOpt *gnfdSdkTypes.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	if !txOpt.NoSimulate {
+		simulateRes, err := c.chainClient.SimulateTx(ctx, msgs, txOpt, opts...)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice, err := c.gasPrice(simulateRes.GasInfo.GetMinGasPrice())
+		if err != nil {
+			return nil, err
+		}
+		gasLimit := simulateRes.GasInfo.GetGasUsed()
+		txOpt.NoSimulate = true
+		txOpt.GasLimit = gasLimit
+		txOpt.FeeAmount = sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(sdk.NewInt(int64(gasLimit)))))
+	}
+	if c.preflightChecks {
+		if err := c.preflightBalance(ctx, txOpt); err != nil {
+			return nil, err
+		}
+	}
+	return c.chainClient.BroadcastTx(ctx, msgs, txOpt, opts...)
+}
+
+// withSigner sets the key manager of the default account as the OverrideKeyManager of txOpt if it is not set, the
+// chain client always signs with the key manager of txOpt so that it never reads the one replaced by SetDefaultAccount
+func (c *client) withSigner(txOpt gnfdSdkTypes.TxOption) (gnfdSdkTypes.TxOption, error) {
+	if c.readOnly {
+		return txOpt, types.ErrorReadOnlyClient
+	}
+	if txOpt.OverrideKeyManager != nil {
+		return txOpt, nil
+	}
+	c.accountMutex.RLock()
+	km, err := c.chainClient.GetKeyManager()
+	c.accountMutex.RUnlock()
+	if err != nil {
+		return txOpt, err
+	}
+	txOpt.OverrideKeyManager = &km
+	return txOpt, nil
+}
+
+// gasPrice returns the gas price decided by the fee policy from the minimum gas price returned by the simulation
+func (c *client) gasPrice(minGasPrice string) (sdk.Coin, error) {
+	minPrice, err := sdk.ParseCoinNormalized(minGasPrice)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if minPrice.IsNil() || minPrice.IsZero() {
+		return sdk.Coin{}, gnfdSdkTypes.SimulatedGasPriceError
+	}
+	return c.feePolicy.GasPrice(minPrice)
+}
+
+// waitTxResult waits for the tx sent by a write API for ContextTimeout at most, unless ctx has an earlier deadline
+func (c *client) waitTxResult(ctx context.Context, txHash string) (*types.TxResult, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	return c.WaitForTxResult(ctxTimeout, txHash)
+}
+
 func (c *client) sendTxn(ctx context.Context, msg sdk.Msg, opt *gnfdSdkTypes.TxOption) (string, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return "", err
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, opt)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, opt)
 	if err != nil {
 		return "", err
 	}
@@ -738,34 +1518,58 @@ func (c *client) sendTxn(ctx context.Context, msg sdk.Msg, opt *gnfdSdkTypes.TxO
 
 // GetDefaultAccount returns the account address of default account in client
 func (c *client) GetDefaultAccount() (*types.Account, error) {
-	if c.MustGetDefaultAccount() == nil {
+	account := c.loadDefaultAccount()
+	if account == nil {
 		return nil, types.ErrorDefaultAccountNotExist
 	}
-	return c.MustGetDefaultAccount(), nil
+	return account, nil
 }
 
-// SetDefaultAccount will set the default account
+// SetDefaultAccount will set the default account, the txs being broadcast keep the account they started with
 func (c *client) SetDefaultAccount(account *types.Account) {
+	// the read-only client has no account, the account is ignored
+	if c.readOnly {
+		return
+	}
+	c.accountMutex.Lock()
+	defer c.accountMutex.Unlock()
 	c.defaultAccount = account
 	c.chainClient.SetKeyManager(account.GetKeyManager())
 }
 
+// signingAccount returns the default account which signs the txs and the requests sent to SP, it fails with
+// types.ErrorReadOnlyClient if the client is read-only
+func (c *client) signingAccount() (*types.Account, error) {
+	if c.readOnly {
+		return nil, types.ErrorReadOnlyClient
+	}
+	return c.GetDefaultAccount()
+}
+
 func (c *client) MustGetDefaultAccount() *types.Account {
-	if c.defaultAccount == nil {
+	account := c.loadDefaultAccount()
+	if account == nil {
 		panic("Default account not exist, Use SetDefaultAccount to set ")
 	}
+	return account
+}
+
+// loadDefaultAccount returns the default account, it is nil if the account is not set
+func (c *client) loadDefaultAccount() *types.Account {
+	c.accountMutex.RLock()
+	defer c.accountMutex.RUnlock()
 	return c.defaultAccount
 }
 
 // getEndpointByOpt return the SP endpoint by listOptions
-func (c *client) getEndpointByOpt(opts *types.EndPointOptions) (*url.URL, error) {
+func (c *client) getEndpointByOpt(ctx context.Context, opts *types.EndPointOptions) (*url.URL, error) {
 	var (
 		endpoint *url.URL
 		useHttps bool
 		err      error
 	)
 	if opts == nil || (opts.Endpoint == "" && opts.SPAddress == "") {
-		endpoint, err = c.getInServiceSP()
+		endpoint, err = c.getInServiceSP(ctx)
 		if err != nil {
 			log.Error().Msg(fmt.Sprintf("get in-service SP fail %s", err.Error()))
 			return nil, err
@@ -784,7 +1588,7 @@ func (c *client) getEndpointByOpt(opts *types.EndPointOptions) (*url.URL, error)
 		}
 	} else if opts.SPAddress != "" {
 		// get endpoint from sp address
-		endpoint, err = c.getSPUrlByAddr(opts.SPAddress)
+		endpoint, err = c.getSPUrlByAddr(ctx, opts.SPAddress)
 		if err != nil {
 			log.Error().Msg(fmt.Sprintf("route endpoint by sp address: %s failed, err: %v", opts.SPAddress, err))
 			return nil, err
@@ -2,15 +2,20 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	"github.com/bnb-chain/greenfield/types/resource"
 	bridgetypes "github.com/bnb-chain/greenfield/x/bridge/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
 	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
 )
 
 type CrossChain interface {
@@ -25,15 +30,25 @@ type CrossChain interface {
 	MirrorGroup(ctx context.Context, groupId math.Uint, groupName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error)
 	MirrorBucket(ctx context.Context, bucketId math.Uint, bucketName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error)
 	MirrorObject(ctx context.Context, objectId math.Uint, bucketName, objectName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error)
+	// GetMirrorStatus returns the mirror status of the bucket, object or group of the id, the token id of the resource
+	// on BSC is the same as the id, see pkg/resource.TokenID
+	GetMirrorStatus(ctx context.Context, resourceType resource.ResourceType, resourceID math.Uint) (*types.MirrorStatus, error)
+	// TrackCrossChainPackage polls the package sent from Greenfield, e.g. by TransferOut or the mirror operations, until
+	// it is delivered, failed or refunded, or ctx is done
+	TrackCrossChainPackage(ctx context.Context, channelId uint32, sequence uint64, opts types.TrackCrossChainPackageOptions) (*types.CrossChainPackage, error)
 }
 
 // TransferOut makes a transfer from Greenfield to BSC
 func (c *client) TransferOut(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgTransferOut := bridgetypes.NewMsgTransferOut(c.MustGetDefaultAccount().GetAddress().String(),
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	msgTransferOut := bridgetypes.NewMsgTransferOut(account.GetAddress().String(),
 		toAddress,
 		&sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount},
 	)
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgTransferOut}, &txOption)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msgTransferOut}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -44,8 +59,12 @@ func (c *client) TransferOut(ctx context.Context, toAddress string, amount math.
 func (c *client) Claims(ctx context.Context, srcShainId, destChainId uint32, sequence uint64,
 	timestamp uint64, payload []byte, voteAddrSet []uint64, aggSignature []byte, txOption gnfdSdkTypes.TxOption,
 ) (*sdk.TxResponse, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
 	msg := oracletypes.NewMsgClaim(
-		c.MustGetDefaultAccount().GetAddress().String(),
+		account.GetAddress().String(),
 		srcShainId,
 		destChainId,
 		sequence,
@@ -54,7 +73,7 @@ func (c *client) Claims(ctx context.Context, srcShainId, destChainId uint32, seq
 		voteAddrSet,
 		aggSignature)
 
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +119,12 @@ func (c *client) GetCrossChainPackage(ctx context.Context, channelId uint32, seq
 
 // MirrorGroup mirrors the group to BSC as NFT
 func (c *client) MirrorGroup(ctx context.Context, groupId math.Uint, groupName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorGroup := storagetypes.NewMsgMirrorGroup(c.MustGetDefaultAccount().GetAddress(), groupId, groupName)
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgMirrorGroup}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorGroup := storagetypes.NewMsgMirrorGroup(account.GetAddress(), groupId, groupName)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msgMirrorGroup}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +133,12 @@ func (c *client) MirrorGroup(ctx context.Context, groupId math.Uint, groupName s
 
 // MirrorBucket mirrors the bucket to BSC as NFT
 func (c *client) MirrorBucket(ctx context.Context, bucketId math.Uint, bucketName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorBucket := storagetypes.NewMsgMirrorBucket(c.MustGetDefaultAccount().GetAddress(), bucketId, bucketName)
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgMirrorBucket}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorBucket := storagetypes.NewMsgMirrorBucket(account.GetAddress(), bucketId, bucketName)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msgMirrorBucket}, &txOption)
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +147,150 @@ func (c *client) MirrorBucket(ctx context.Context, bucketId math.Uint, bucketNam
 
 // MirrorObject mirrors the object to BSC as NFT
 func (c *client) MirrorObject(ctx context.Context, objectId math.Uint, bucketName, objectName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorObject := storagetypes.NewMsgMirrorObject(c.MustGetDefaultAccount().GetAddress(), objectId, bucketName, objectName)
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgMirrorObject}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorObject := storagetypes.NewMsgMirrorObject(account.GetAddress(), objectId, bucketName, objectName)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msgMirrorObject}, &txOption)
 	if err != nil {
 		return nil, err
 	}
 	return txResp.TxResponse, nil
 }
+
+// GetMirrorStatus queries the NFT metadata of the resource, whose attributes include the source type
+func (c *client) GetMirrorStatus(ctx context.Context, resourceType resource.ResourceType, resourceID math.Uint) (*types.MirrorStatus, error) {
+	req := &storagetypes.QueryNFTRequest{TokenId: resourceID.String()}
+	status := &types.MirrorStatus{ResourceType: resourceType, TokenID: resourceID}
+	var traits []storagetypes.Trait
+	switch resourceType {
+	case resource.RESOURCE_TYPE_BUCKET:
+		resp, err := c.chainClient.HeadBucketNFT(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		status.Name, traits = resp.MetaData.BucketName, resp.MetaData.Attributes
+	case resource.RESOURCE_TYPE_OBJECT:
+		resp, err := c.chainClient.HeadObjectNFT(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		status.Name, traits = resp.MetaData.ObjectName, resp.MetaData.Attributes
+	case resource.RESOURCE_TYPE_GROUP:
+		resp, err := c.chainClient.HeadGroupNFT(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		status.Name, traits = resp.MetaData.GroupName, resp.MetaData.Attributes
+	default:
+		return nil, fmt.Errorf("the resource type %s can not be mirrored", resourceType)
+	}
+
+	status.Attributes = make(map[string]string, len(traits))
+	for _, trait := range traits {
+		status.Attributes[trait.TraitType] = trait.Value
+	}
+	sourceType, ok := storagetypes.SourceType_value[status.Attributes["SourceType"]]
+	if !ok {
+		return nil, fmt.Errorf("unknown source type %q of %s %s", status.Attributes["SourceType"], resourceType, resourceID)
+	}
+	status.SourceType = storagetypes.SourceType(sourceType)
+	return status, nil
+}
+
+// TrackCrossChainPackage waits for the package to be committed on Greenfield, then polls the resolver until the
+// package is finalized
+func (c *client) TrackCrossChainPackage(ctx context.Context, channelId uint32, sequence uint64,
+	opts types.TrackCrossChainPackageOptions,
+) (*types.CrossChainPackage, error) {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = types.TrackCrossChainPackageInterval
+	}
+	resolver := opts.Resolver
+	if resolver == nil {
+		resolver = types.CrossChainPackageResolverFunc(c.resolveMirrorPackage)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var pkg *types.CrossChainPackage
+	for {
+		if pkg == nil {
+			rawPackage, err := c.GetCrossChainPackage(ctx, channelId, sequence)
+			if err != nil {
+				return nil, err
+			}
+			// the package is empty until the tx sending it is committed
+			if len(rawPackage) > 0 {
+				if pkg, err = types.DecodeCrossChainPackage(channelId, sequence, rawPackage); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if pkg != nil {
+			status, err := resolver.ResolveCrossChainPackage(ctx, pkg)
+			if err != nil {
+				return nil, err
+			}
+			if status != types.CrossChainPackagePending {
+				pkg.Status = status
+				return pkg, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cross-chain package %d on channel %d is not finalized: %v", sequence, channelId, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolveMirrorPackage resolves the mirror packages by the source type of the mirrored resources, which stays
+// SOURCE_TYPE_MIRROR_PENDING until the ack package is relayed back from BSC and is reverted to SOURCE_TYPE_ORIGIN if
+// the mirror fails
+func (c *client) resolveMirrorPackage(ctx context.Context, pkg *types.CrossChainPackage) (types.CrossChainPackageStatus, error) {
+	switch sdk.ChannelID(pkg.ChannelId) {
+	case storagetypes.BucketChannelId, storagetypes.ObjectChannelId, storagetypes.GroupChannelId:
+	default:
+		return "", fmt.Errorf("the packages on channel %d can not be resolved on Greenfield, a resolver is required to track them",
+			pkg.ChannelId)
+	}
+	if pkg.PackageType != sdk.SynCrossChainPackageType || len(pkg.Payload) == 0 {
+		return "", fmt.Errorf("the package %d on channel %d is not sent by a mirror operation", pkg.Sequence, pkg.ChannelId)
+	}
+	synPackage, err := storagetypes.DeserializeCrossChainPackage(pkg.Payload, sdk.ChannelID(pkg.ChannelId), sdk.SynCrossChainPackageType)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		resourceType resource.ResourceType
+		resourceID   math.Uint
+	)
+	switch p := synPackage.(type) {
+	case *storagetypes.MirrorBucketSynPackage:
+		resourceType, resourceID = resource.RESOURCE_TYPE_BUCKET, math.NewUintFromBigInt(p.Id)
+	case *storagetypes.MirrorObjectSynPackage:
+		resourceType, resourceID = resource.RESOURCE_TYPE_OBJECT, math.NewUintFromBigInt(p.Id)
+	case *storagetypes.MirrorGroupSynPackage:
+		resourceType, resourceID = resource.RESOURCE_TYPE_GROUP, math.NewUintFromBigInt(p.Id)
+	default:
+		return "", fmt.Errorf("the package %d on channel %d is not sent by a mirror operation, a resolver is required to track it",
+			pkg.Sequence, pkg.ChannelId)
+	}
+
+	status, err := c.GetMirrorStatus(ctx, resourceType, resourceID)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case status.Pending():
+		return types.CrossChainPackagePending, nil
+	case status.Mirrored():
+		return types.CrossChainPackageDelivered, nil
+	default:
+		return types.CrossChainPackageFailed, nil
+	}
+}
@@ -18,12 +18,16 @@ type Distribution interface {
 
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
 func (c *client) SetWithdrawAddress(ctx context.Context, withdrawAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	withdraw, err := sdk.AccAddressFromHexUnsafe(withdrawAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := distrtypes.NewMsgSetWithdrawAddress(c.MustGetDefaultAccount().GetAddress(), withdraw)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	msg := distrtypes.NewMsgSetWithdrawAddress(account.GetAddress(), withdraw)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -32,8 +36,12 @@ func (c *client) SetWithdrawAddress(ctx context.Context, withdrawAddr string, tx
 
 // WithdrawValidatorCommission withdraw accumulated commission by validator
 func (c *client) WithdrawValidatorCommission(ctx context.Context, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := distrtypes.NewMsgWithdrawValidatorCommission(c.MustGetDefaultAccount().GetAddress())
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	msg := distrtypes.NewMsgWithdrawValidatorCommission(account.GetAddress())
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -42,12 +50,16 @@ func (c *client) WithdrawValidatorCommission(ctx context.Context, txOption gnfds
 
 // WithdrawDelegatorReward  withdraw rewards by a delegator
 func (c *client) WithdrawDelegatorReward(ctx context.Context, validatorAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdk.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := distrtypes.NewMsgWithdrawDelegatorReward(c.MustGetDefaultAccount().GetAddress(), validator)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(account.GetAddress(), validator)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -56,8 +68,12 @@ func (c *client) WithdrawDelegatorReward(ctx context.Context, validatorAddr stri
 
 // FundCommunityPool sends coins directly from the sender to the community pool.
 func (c *client) FundCommunityPool(ctx context.Context, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := distrtypes.NewMsgFundCommunityPool(sdk.Coins{sdk.Coin{Denom: gnfdsdktypes.Denom, Amount: amount}}, c.MustGetDefaultAccount().GetAddress())
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	msg := distrtypes.NewMsgFundCommunityPool(sdk.Coins{sdk.Coin{Denom: gnfdsdktypes.Denom, Amount: amount}}, account.GetAddress())
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,22 @@
+package client
+
+// Bucket returns the client itself as Bucket
+func (c *client) Bucket() Bucket { return c }
+
+// Object returns the client itself as Object
+func (c *client) Object() Object { return c }
+
+// Group returns the client itself as Group
+func (c *client) Group() Group { return c }
+
+// Permission returns the client itself as Permission
+func (c *client) Permission() Permission { return c }
+
+// Payment returns the client itself as Payment
+func (c *client) Payment() Payment { return c }
+
+// SP returns the client itself as SP
+func (c *client) SP() SP { return c }
+
+// Chain returns the client itself as Basic
+func (c *client) Chain() Basic { return c }
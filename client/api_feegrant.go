@@ -24,6 +24,10 @@ type FeeGrant interface {
 
 // GrantBasicAllowance grants the grantee the BasicAllowance with specified amount and expiration.
 func (c *client) GrantBasicAllowance(ctx context.Context, granteeAddr string, feeAllowanceAmount math.Int, expiration *time.Time, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
@@ -33,11 +37,11 @@ func (c *client) GrantBasicAllowance(ctx context.Context, granteeAddr string, fe
 		SpendLimit: bnb,
 		Expiration: expiration,
 	}
-	msg, err := feegrant.NewMsgGrantAllowance(&allowance, c.defaultAccount.GetAddress(), grantee)
+	msg, err := feegrant.NewMsgGrantAllowance(&allowance, account.GetAddress(), grantee)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -46,15 +50,19 @@ func (c *client) GrantBasicAllowance(ctx context.Context, granteeAddr string, fe
 
 // GrantAllowance provides a generic way to grant different types of allowance(BasicAllowance, PeriodicAllowance, AllowedMsgAllowance), the user needs to construct the desired type of allowance
 func (c *client) GrantAllowance(ctx context.Context, granteeAddr string, allowance feegrant.FeeAllowanceI, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
 	}
-	msg, err := feegrant.NewMsgGrantAllowance(allowance, c.defaultAccount.GetAddress(), grantee)
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, account.GetAddress(), grantee)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -63,15 +71,19 @@ func (c *client) GrantAllowance(ctx context.Context, granteeAddr string, allowan
 
 // RevokeAllowance revokes allowance on a grantee by the granter
 func (c *client) RevokeAllowance(ctx context.Context, granteeAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := feegrant.NewMsgRevokeAllowance(c.defaultAccount.GetAddress(), grantee)
+	msg := feegrant.NewMsgRevokeAllowance(account.GetAddress(), grantee)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{&msg}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{&msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -20,14 +20,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
 
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
 	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
 )
 
 type Group interface {
 	// CreateGroup create a new group on greenfield chain the group members can be initialized  or not
 	CreateGroup(ctx context.Context, groupName string, opt types.CreateGroupOptions) (string, error)
+	// CreateGroupAndWait creates the group like CreateGroup and waits for the transaction to be committed, the
+	// returned events carry the id of the group in *storageTypes.EventCreateGroup
+	CreateGroupAndWait(ctx context.Context, groupName string, opt types.CreateGroupOptions) (*types.TxResult, error)
+	// GetGroupIDFromTx waits for the CreateGroup transaction to be committed and returns the id of the created group
+	GetGroupIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error)
 	// DeleteGroup send DeleteGroup txn to greenfield chain and return txn hash
 	DeleteGroup(ctx context.Context, groupName string, opt types.DeleteGroupOption) (string, error)
+	// DeleteGroupAndWait deletes the group like DeleteGroup and waits for the transaction to be committed
+	DeleteGroupAndWait(ctx context.Context, groupName string, opt types.DeleteGroupOption) (*types.TxResult, error)
 	// UpdateGroupMember support adding or removing members from the group and return the txn hash
 	// groupOwnerAddr indicates the HEX-encoded string of the group owner address
 	// addAddresses indicates the HEX-encoded string list of the member addresses to be added
@@ -35,6 +43,12 @@ type Group interface {
 	// expirationTime  indicates the expiration time of the group member, user need set the expiration time for the addAddresses
 	UpdateGroupMember(ctx context.Context, groupName string, groupOwnerAddr string,
 		addAddresses, removeAddresses []string, expirationTime []time.Time, opts types.UpdateGroupMemberOption) (string, error)
+	// UpdateGroupMembers adds and removes the members of the group in one transaction, each added member carries its
+	// own expiration time, e.g. for the jobs reconciling the members of the group with an external directory
+	// groupOwnerAddr indicates the HEX-encoded string of the group owner address
+	UpdateGroupMembers(ctx context.Context, groupName string, groupOwnerAddr string, update types.GroupMemberUpdate, opts types.UpdateGroupMemberOption) (string, error)
+	// UpdateGroupMembersAndWait updates the members like UpdateGroupMembers and waits for the transaction to be committed
+	UpdateGroupMembersAndWait(ctx context.Context, groupName string, groupOwnerAddr string, update types.GroupMemberUpdate, opts types.UpdateGroupMemberOption) (*types.TxResult, error)
 	// LeaveGroup make the member leave the specific group
 	// groupOwnerAddr indicates the HEX-encoded string of the group owner address
 	LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (string, error)
@@ -44,7 +58,13 @@ type Group interface {
 	// HeadGroupMember query the group member info on chain, return true if the member exists in group
 	// groupOwnerAddr indicates the HEX-encoded string of the group owner address
 	// headMember indicates the HEX-encoded string of the group member address
+	//
+	// Deprecated: the failures of the query are also reported as false, use HeadGroupMemberE instead
 	HeadGroupMember(ctx context.Context, groupName string, groupOwner, headMember string) bool
+	// HeadGroupMemberE query the group member info on chain, return true if the member exists in group, and false
+	// with nil error if it does not. The failures of the query, e.g. the group does not exist or the RPC fails, are
+	// returned as errors rather than reported as the absence of the member.
+	HeadGroupMemberE(ctx context.Context, groupName string, groupOwner, headMember string) (bool, error)
 	// PutGroupPolicy apply group policy to user specified by principalAddr, the sender need to be the owner of the group
 	// principalAddr indicates the HEX-encoded string of the principal address
 	PutGroupPolicy(ctx context.Context, groupName string, principalAddr string, statements []*permTypes.Statement, opt types.PutPolicyOption) (string, error)
@@ -64,26 +84,93 @@ type Group interface {
 	// name is the ending of the search pattern.
 	// it providers fuzzy searches by inputting a specific name and prefix
 	ListGroup(ctx context.Context, name, prefix string, opts types.ListGroupsOptions) (types.ListGroupsResult, error)
+	// ListGroupIterator return an iterator which lazily lists all the groups matching the name and prefix,
+	// advancing the offset page by page starting from opts.Offset
+	ListGroupIterator(ctx context.Context, name, prefix string, opts types.ListGroupsOptions) *types.Iterator[*types.GroupMeta]
+	// ListGroupsByOwner lists a page of the groups owned by opts.Owner, or by the default account if it is not set,
+	// in the order of the group id. The NamePrefix is applied to the page listed from SP, so a page may contain fewer
+	// groups than the limit even if it is not the last one, which is indicated by an empty NextStartAfter.
+	ListGroupsByOwner(ctx context.Context, opts types.ListGroupsByOwnerOptions) (types.ListGroupsByOwnerResult, error)
+	// ListGroupsByOwnerIterator return an iterator which lazily lists all the groups owned by the owner page by page
+	ListGroupsByOwnerIterator(ctx context.Context, opts types.ListGroupsByOwnerOptions) *types.Iterator[*types.GroupMeta]
 	// RenewGroupMember renew a list of group members and their expiration time
 	RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName string, memberAddresses []string, expirationTime []time.Time, opts types.RenewGroupMemberOption) (string, error)
 }
 
 // CreateGroup create a new group on greenfield chain, the group members can be initialized or not
 func (c *client) CreateGroup(ctx context.Context, groupName string, opt types.CreateGroupOptions) (string, error) {
-	createGroupMsg := storageTypes.NewMsgCreateGroup(c.MustGetDefaultAccount().GetAddress(), groupName, opt.Extra)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	createGroupMsg := storageTypes.NewMsgCreateGroup(account.GetAddress(), groupName, opt.Extra)
 	return c.sendTxn(ctx, createGroupMsg, opt.TxOpts)
 }
 
+// CreateGroupAndWait sends the CreateGroup txn and waits for it to be committed
+func (c *client) CreateGroupAndWait(ctx context.Context, groupName string, opt types.CreateGroupOptions) (*types.TxResult, error) {
+	txHash, err := c.CreateGroup(ctx, groupName, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
+// GetGroupIDFromTx returns the group id assigned by the CreateGroup transaction of the hash
+func (c *client) GetGroupIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error) {
+	txEvents, err := c.GetTxEvents(ctx, txHash)
+	if err != nil {
+		return storageTypes.Uint{}, err
+	}
+	event, ok := events.FindEvent[*storageTypes.EventCreateGroup](txEvents)
+	if !ok {
+		return storageTypes.Uint{}, types.ErrorEventNotFound
+	}
+	return event.GroupId, nil
+}
+
 // DeleteGroup send DeleteGroup txn to greenfield chain and return txn hash
 func (c *client) DeleteGroup(ctx context.Context, groupName string, opt types.DeleteGroupOption) (string, error) {
-	deleteGroupMsg := storageTypes.NewMsgDeleteGroup(c.MustGetDefaultAccount().GetAddress(), groupName)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	deleteGroupMsg := storageTypes.NewMsgDeleteGroup(account.GetAddress(), groupName)
 	return c.sendTxn(ctx, deleteGroupMsg, opt.TxOpts)
 }
 
+// DeleteGroupAndWait sends the DeleteGroup txn and waits for it to be committed
+func (c *client) DeleteGroupAndWait(ctx context.Context, groupName string, opt types.DeleteGroupOption) (*types.TxResult, error) {
+	txHash, err := c.DeleteGroup(ctx, groupName, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
 // UpdateGroupMember support adding or removing members from the group and return the txn hash
 func (c *client) UpdateGroupMember(ctx context.Context, groupName string, groupOwnerAddr string,
 	addAddresses, removeAddresses []string, expirationTime []time.Time, opts types.UpdateGroupMemberOption,
 ) (string, error) {
+	if len(addAddresses) != len(expirationTime) {
+		return "", errors.New("please provide expirationTime for every new add member")
+	}
+	update := types.GroupMemberUpdate{Remove: removeAddresses}
+	for idx, addr := range addAddresses {
+		update.Add = append(update.Add, types.GroupMemberToAdd{Address: addr, ExpirationTime: expirationTime[idx]})
+	}
+	return c.UpdateGroupMembers(ctx, groupName, groupOwnerAddr, update, opts)
+}
+
+// UpdateGroupMembers composes the added and the removed members into one MsgUpdateGroupMember, a member can not be
+// both added and removed, and the added members without expiration time never expire
+func (c *client) UpdateGroupMembers(ctx context.Context, groupName string, groupOwnerAddr string,
+	update types.GroupMemberUpdate, opts types.UpdateGroupMemberOption,
+) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
@@ -92,49 +179,73 @@ func (c *client) UpdateGroupMember(ctx context.Context, groupName string, groupO
 		return "", errors.New("group name is empty")
 	}
 
-	if len(addAddresses) == 0 && len(removeAddresses) == 0 {
+	if len(update.Add) == 0 && len(update.Remove) == 0 {
 		return "", errors.New("no update member")
 	}
 
-	addMembers := make([]*storageTypes.MsgGroupMember, 0)
-	removeMembers := make([]sdk.AccAddress, 0)
-
-	if len(addAddresses) != len(expirationTime) {
-		return "", errors.New("please provide expirationTime for every new add member")
-	}
+	addMembers := make([]*storageTypes.MsgGroupMember, 0, len(update.Add))
+	removeMembers := make([]sdk.AccAddress, 0, len(update.Remove))
+	// seen records the updated members to reject the duplicated and the conflicting updates
+	seen := make(map[string]bool, len(update.Add)+len(update.Remove))
 
-	for idx, addr := range addAddresses {
-		_, err := sdk.AccAddressFromHexUnsafe(addr)
+	for _, addMember := range update.Add {
+		member, err := sdk.AccAddressFromHexUnsafe(addMember.Address)
 		if err != nil {
 			return "", err
 		}
-		m := &storageTypes.MsgGroupMember{
-			Member:         addr,
-			ExpirationTime: expirationTime[idx],
+		if seen[member.String()] {
+			return "", fmt.Errorf("member %s is updated more than once", addMember.Address)
+		}
+		seen[member.String()] = true
+		expirationTime := addMember.ExpirationTime
+		if expirationTime.IsZero() {
+			expirationTime = storageTypes.MaxTimeStamp
 		}
-		addMembers = append(addMembers, m)
+		addMembers = append(addMembers, &storageTypes.MsgGroupMember{
+			Member:         member.String(),
+			ExpirationTime: expirationTime,
+		})
 	}
 
-	for _, addr := range removeAddresses {
+	for _, addr := range update.Remove {
 		member, err := sdk.AccAddressFromHexUnsafe(addr)
 		if err != nil {
 			return "", err
 		}
+		if seen[member.String()] {
+			return "", fmt.Errorf("member %s is updated more than once", addr)
+		}
+		seen[member.String()] = true
 		removeMembers = append(removeMembers, member)
 	}
 
-	updateGroupMsg := storageTypes.NewMsgUpdateGroupMember(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName, addMembers, removeMembers)
+	updateGroupMsg := storageTypes.NewMsgUpdateGroupMember(account.GetAddress(), groupOwner, groupName, addMembers, removeMembers)
 
 	return c.sendTxn(ctx, updateGroupMsg, opts.TxOpts)
 }
 
+// UpdateGroupMembersAndWait sends the UpdateGroupMember txn of the update and waits for it to be committed
+func (c *client) UpdateGroupMembersAndWait(ctx context.Context, groupName string, groupOwnerAddr string,
+	update types.GroupMemberUpdate, opts types.UpdateGroupMemberOption,
+) (*types.TxResult, error) {
+	txHash, err := c.UpdateGroupMembers(ctx, groupName, groupOwnerAddr, update, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
 // LeaveGroup make the member leave the specific group
 func (c *client) LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
 	}
-	leaveGroupMsg := storageTypes.NewMsgLeaveGroup(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName)
+	leaveGroupMsg := storageTypes.NewMsgLeaveGroup(account.GetAddress(), groupOwner, groupName)
 	return c.sendTxn(ctx, leaveGroupMsg, opt.TxOpts)
 }
 
@@ -155,7 +266,16 @@ func (c *client) HeadGroup(ctx context.Context, groupName string, groupOwnerAddr
 }
 
 // HeadGroupMember query the group member info on chain, return true if the member exists in group
+//
+// Deprecated: the failures of the query are also reported as false, use HeadGroupMemberE instead
 func (c *client) HeadGroupMember(ctx context.Context, groupName string, groupOwnerAddr, headMemberAddr string) bool {
+	exist, _ := c.HeadGroupMemberE(ctx, groupName, groupOwnerAddr, headMemberAddr)
+	return exist
+}
+
+// HeadGroupMemberE query the group member info on chain, only the ErrNoSuchGroupMember of the chain is reported as
+// the absence of the member
+func (c *client) HeadGroupMemberE(ctx context.Context, groupName string, groupOwnerAddr, headMemberAddr string) (bool, error) {
 	headGroupRequest := storageTypes.QueryHeadGroupMemberRequest{
 		GroupName:  groupName,
 		GroupOwner: groupOwnerAddr,
@@ -163,14 +283,24 @@ func (c *client) HeadGroupMember(ctx context.Context, groupName string, groupOwn
 	}
 
 	_, err := c.chainClient.HeadGroupMember(ctx, &headGroupRequest)
-	return err == nil
+	if err != nil {
+		if strings.Contains(err.Error(), storageTypes.ErrNoSuchGroupMember.Error()) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 // PutGroupPolicy apply group policy to user specified by principalAddr, the sender need to be the owner of the group
 func (c *client) PutGroupPolicy(ctx context.Context, groupName string, principalAddr string,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
 ) (string, error) {
-	sender := c.MustGetDefaultAccount().GetAddress()
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	sender := account.GetAddress()
 
 	resource := gnfdTypes.NewGroupGRN(sender, groupName)
 
@@ -222,7 +352,11 @@ func (c *client) GetObjectPolicyOfGroup(ctx context.Context, bucketName, objectN
 
 // DeleteGroupPolicy delete group policy of the principal, the sender need to be the owner of the group
 func (c *client) DeleteGroupPolicy(ctx context.Context, groupName string, principalAddr string, opt types.DeletePolicyOption) (string, error) {
-	sender := c.MustGetDefaultAccount().GetAddress()
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	sender := account.GetAddress()
 	resource := gnfdTypes.NewGroupGRN(sender, groupName).String()
 
 	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
@@ -241,8 +375,11 @@ func (c *client) GetGroupPolicy(ctx context.Context, groupName string, principal
 	if err != nil {
 		return nil, err
 	}
-	sender := c.MustGetDefaultAccount().GetAddress()
-	resource := gnfdTypes.NewGroupGRN(sender, groupName).String()
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	resource := gnfdTypes.NewGroupGRN(account.GetAddress(), groupName).String()
 
 	queryPolicy := storageTypes.QueryPolicyForAccountRequest{
 		Resource:         resource,
@@ -308,7 +445,7 @@ func (c *client) ListGroup(ctx context.Context, name, prefix string, opts types.
 		disableCloseBody: true,
 	}
 
-	endpoint, err := c.getEndpointByOpt(opts.EndPointOptions)
+	endpoint, err := c.getEndpointByOpt(ctx, opts.EndPointOptions)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
 		return types.ListGroupsResult{}, err
@@ -340,9 +477,116 @@ func (c *client) ListGroup(ctx context.Context, name, prefix string, opts types.
 	return listGroupsResult, nil
 }
 
+// ListGroupIterator return an iterator which lazily lists all the groups matching the name and prefix
+func (c *client) ListGroupIterator(ctx context.Context, name, prefix string, opts types.ListGroupsOptions) *types.Iterator[*types.GroupMeta] {
+	return types.NewIterator(func() ([]*types.GroupMeta, bool, error) {
+		result, err := c.ListGroup(ctx, name, prefix, opts)
+		if err != nil {
+			return nil, false, err
+		}
+		opts.Offset += int64(len(result.Groups))
+		return result.Groups, len(result.Groups) > 0 && opts.Offset < result.Count, nil
+	})
+}
+
+// ListGroupsByOwner lists the groups owned by the owner from the SP metadata service
+func (c *client) ListGroupsByOwner(ctx context.Context, opts types.ListGroupsByOwnerOptions) (types.ListGroupsByOwnerResult, error) {
+	if err := opts.Validate(); err != nil {
+		return types.ListGroupsByOwnerResult{}, err
+	}
+	owner := opts.Owner
+	if owner == "" {
+		account, err := c.GetDefaultAccount()
+		if err != nil {
+			return types.ListGroupsByOwnerResult{}, err
+		}
+		owner = account.GetAddress().String()
+	} else if _, err := sdk.AccAddressFromHexUnsafe(owner); err != nil {
+		return types.ListGroupsByOwnerResult{}, err
+	}
+
+	if opts.Limit < 0 {
+		return types.ListGroupsByOwnerResult{}, errors.New("limit should not be negative")
+	} else if opts.Limit == 0 {
+		opts.Limit = types.ListGroupsDefaultLimit
+	} else if opts.Limit > types.ListGroupsMaxLimit {
+		opts.Limit = types.ListGroupsMaxLimit
+	}
+
+	params := url.Values{}
+	params.Set("owned-groups", "")
+	params.Set("owner", owner)
+	params.Set("start-after", opts.StartAfter)
+	params.Set("limit", strconv.FormatInt(opts.Limit, 10))
+	reqMeta := requestMeta{
+		urlValues:     params,
+		contentSHA256: types.EmptyStringSHA256,
+		userAddress:   owner,
+	}
+
+	sendOpt := sendOptions{
+		method:           http.MethodGet,
+		disableCloseBody: true,
+	}
+
+	endpoint, err := c.getEndpointByOpt(ctx, opts.EndPointOptions)
+	if err != nil {
+		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
+		return types.ListGroupsByOwnerResult{}, err
+	}
+
+	resp, err := c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
+	if err != nil {
+		log.Error().Msg("the list of owned groups failed: " + err.Error())
+		return types.ListGroupsByOwnerResult{}, err
+	}
+	defer utils.CloseResponse(resp)
+
+	listResult := types.ListGroupsByOwnerResult{}
+	// decode the json content from response body
+	err = json.NewDecoder(resp.Body).Decode(&listResult)
+	if err != nil && listResult.Groups == nil {
+		log.Error().Msg("the list of owned groups failed: " + err.Error())
+		return types.ListGroupsByOwnerResult{}, err
+	}
+
+	// the next page starts after the last group listed from SP, before the groups are filtered by the prefix
+	if int64(len(listResult.Groups)) >= opts.Limit {
+		if last := listResult.Groups[len(listResult.Groups)-1]; last.Group != nil {
+			listResult.NextStartAfter = last.Group.Id.String()
+		}
+	}
+	if opts.NamePrefix != "" {
+		groups := make([]*types.GroupMeta, 0, len(listResult.Groups))
+		for _, group := range listResult.Groups {
+			if group.Group != nil && strings.HasPrefix(group.Group.GroupName, opts.NamePrefix) {
+				groups = append(groups, group)
+			}
+		}
+		listResult.Groups = groups
+	}
+	return listResult, nil
+}
+
+// ListGroupsByOwnerIterator return an iterator which lazily lists the groups owned by the owner page by page
+func (c *client) ListGroupsByOwnerIterator(ctx context.Context, opts types.ListGroupsByOwnerOptions) *types.Iterator[*types.GroupMeta] {
+	return types.NewIterator(func() ([]*types.GroupMeta, bool, error) {
+		result, err := c.ListGroupsByOwner(ctx, opts)
+		if err != nil {
+			return nil, false, err
+		}
+		opts.StartAfter = result.NextStartAfter
+		return result.Groups, result.NextStartAfter != "", nil
+	})
+}
+
 func (c *client) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName string,
 	memberAddresses []string, expirationTime []time.Time, opts types.RenewGroupMemberOption,
 ) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
@@ -365,6 +609,6 @@ func (c *client) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName
 		}
 		renewMembers = append(renewMembers, m)
 	}
-	msg := storageTypes.NewMsgRenewGroupMember(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName, renewMembers)
+	msg := storageTypes.NewMsgRenewGroupMember(account.GetAddress(), groupOwner, groupName, renewMembers)
 	return c.sendTxn(ctx, msg, opts.TxOpts)
 }
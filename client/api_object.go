@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/base64"
@@ -11,16 +12,22 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
+	resourceUtils "github.com/bnb-chain/greenfield-go-sdk/pkg/resource"
 	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 	gnfdsdk "github.com/bnb-chain/greenfield/sdk/types"
@@ -30,20 +37,89 @@ import (
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/rs/zerolog/log"
 )
 
 type Object interface {
 	GetCreateObjectApproval(ctx context.Context, createObjectMsg *storageTypes.MsgCreateObject) (*storageTypes.MsgCreateObject, error)
 	CreateObject(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (string, error)
+	// CreateObjectAndWait creates the object like CreateObject and waits for the transaction to be committed, the
+	// returned events carry the id of the object in *storageTypes.EventCreateObject
+	CreateObjectAndWait(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (*types.TxResult, error)
+	// DryRunCreateObject constructs the createObject msg with the SP approval and simulates it without broadcasting
+	DryRunCreateObject(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (*types.DryRunResult, error)
+	// BuildCreateObjectMsg constructs and validates the createObject msg without broadcasting it, the approval
+	// signature of the primary SP is set in the PrimarySpApproval of the msg
+	BuildCreateObjectMsg(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (*storageTypes.MsgCreateObject, error)
+	// ListPendingObjects returns the approvals left in the approval store of the client, i.e. the objects whose
+	// creation or upload has not finished, e.g. the worker crashed before uploading the payload
+	ListPendingObjects(ctx context.Context) ([]*types.StoredApproval, error)
+	// ExportChecksums downloads the stored payload of the sealed object and returns its hash tree, i.e. the hashes of
+	// the segments and the erasure-coded pieces, after checking the roots against the on-chain checksums. The manifest
+	// can be written by WriteTo for the auditors to verify the downloaded data offline.
+	ExportChecksums(ctx context.Context, bucketName, objectName string) (*types.ChecksumManifest, error)
+	// GetObjectIDFromTx waits for the CreateObject transaction to be committed and returns the id of the created object
+	GetObjectIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error)
 	PutObject(ctx context.Context, bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error
 	putObjectResumable(ctx context.Context, bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error
 	FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectOptions) (err error)
+	// PutObjectFromFile creates the object with the payload of the local file, uploads the file and waits for the
+	// object to be sealed, the content type is detected from the file extension if it is not set
+	PutObjectFromFile(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectFromFileOptions) (string, error)
+	// PutObjectOverwrite replaces the object with the payload of the reader, or creates it if it does not exist, and
+	// waits for the object to be sealed unless DisableWaitSeal is set. The payload is spooled to a local file and the
+	// existing object is replaced in the order of opts.Strategy. It returns the hash of the createObject txn.
+	PutObjectOverwrite(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.PutObjectOverwriteOptions) (string, error)
+	// UploadStream creates the object and uploads the payload of the stream whose length is only known at the end, e.g.
+	// a live transcoding output. The stream is spooled to local segment files while the checksums are computed, and
+	// the object is created once the stream ends. It returns the hash of the createObject txn.
+	UploadStream(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.SpoolOptions) (string, error)
+	// NewObjectWriter returns a writer implementing io.WriteCloser of the object, so that the encoders and loggers
+	// written against io.Writer can write to the object directly. The data is spooled like UploadStream and the object
+	// is created and uploaded when the writer is closed, Close returns the error of the upload.
+	NewObjectWriter(ctx context.Context, bucketName, objectName string, opts types.SpoolOptions) (*ObjectWriter, error)
+	// OpenAppendLog returns an AppendLog which rolls the written data over the objects named <name>-YYYYMMDD-N by
+	// size, time and date, the rolled objects are listed in the manifest object <name>.manifest.json in order.
+	// The log is continued if the manifest exists.
+	OpenAppendLog(ctx context.Context, bucketName, name string, opts types.AppendLogOptions) (*AppendLog, error)
+	// SignUploadPermit signs the upload request of the object payload with the default account and returns the permit,
+	// which can be handed over to a third party performing the HTTP PUT to the SP
+	SignUploadPermit(ctx context.Context, bucketName, objectName string, objectSize int64, opts types.PutObjectOptions) (*types.UploadPermit, error)
 	CancelCreateObject(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (string, error)
+	// CancelCreateObjectAndWait cancels the creation like CancelCreateObject and waits for the transaction to be committed
+	CancelCreateObjectAndWait(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (*types.TxResult, error)
 	DeleteObject(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (string, error)
+	// DeleteObjectAndWait deletes the object like DeleteObject and waits for the transaction to be committed
+	DeleteObjectAndWait(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (*types.TxResult, error)
+	// DeleteObjects deletes the objects of the bucket in batched transactions, each batch waits for the previous one to be committed
+	DeleteObjects(ctx context.Context, bucketName string, objectNames []string, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error)
+	// DeleteObjectsByPrefix lists the objects whose names begin with the prefix and deletes them in batched transactions.
+	// The objects are only listed if opts.DryRun is set
+	DeleteObjectsByPrefix(ctx context.Context, bucketName, prefix string, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error)
+	// CancelStaleCreates cancels the objects of the bucket which are created longer than olderThan ago but not sealed,
+	// e.g. the payload is never uploaded or the sealing failed, in batched transactions. The canceled objects stop
+	// being charged and their names can be created again.
+	CancelStaleCreates(ctx context.Context, bucketName string, olderThan time.Duration, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error)
+	// GetObject downloads the object, the request is sent anonymously if the client has no default account,
+	// in which case only the public-read objects can be downloaded
 	GetObject(ctx context.Context, bucketName, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error)
 	FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error
 	FGetObjectResumable(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error
+	// PresignPutObject signs the request uploading the payload of the object created on chain by the default account,
+	// so a browser can upload the payload to the SP directly within the expiry instead of proxying it by the backend
+	PresignPutObject(ctx context.Context, bucketName, objectName string, opts types.PresignPutObjectOptions) (*types.PresignedUpload, error)
+	// VerifyPresignedRequest verifies the request is authorized by the signer and has not expired, e.g. the uploads
+	// signed by PresignPutObject, it returns types.ErrorInvalidAuthorization if not
+	VerifyPresignedRequest(req *http.Request, signerAddr string) error
+	// NewObjectReader returns a reader implementing io.ReaderAt, io.Seeker and io.Closer of the object, which reads the
+	// object lazily with range requests, e.g. for reading formats like parquet and zip without downloading the whole object
+	NewObjectReader(ctx context.Context, bucketName, objectName string) (*ObjectReader, error)
+	// WatchObject delivers the status transitions of the object on the returned channel, e.g. CREATED to SEALED,
+	// until the object is deleted, ctx is done or the client is closed, then the channel is closed. The status is
+	// queried on the storage events of the object if the client uses the websocket connection, otherwise it is polled.
+	WatchObject(ctx context.Context, bucketName, objectName string, opts types.WatchObjectOptions) (<-chan types.ObjectStatusEvent, error)
 
 	// HeadObject query the objectInfo on chain to check th object id, return the object info if exists
 	// return err info if object not exist
@@ -51,10 +127,16 @@ type Object interface {
 	// HeadObjectByID query the objectInfo on chain by object id, return the object info if exists
 	// return err info if object not exist
 	HeadObjectByID(ctx context.Context, objID string) (*types.ObjectDetail, error)
+	// GetObjectsSealStatus queries the seal status of the objects in the bucket concurrently, e.g. to report the
+	// completion of a bulk upload. The results are in the order of objectNames, and the failure of querying an object
+	// is reported in the Err of its result instead of failing the whole batch.
+	GetObjectsSealStatus(ctx context.Context, bucketName string, objectNames []string) ([]types.ObjectSealStatus, error)
 	// UpdateObjectVisibility update the visibility of the object
 	UpdateObjectVisibility(ctx context.Context, bucketName, objectName string, visibility storageTypes.VisibilityType, opt types.UpdateObjectOption) (string, error)
 	// PutObjectPolicy apply object policy to the principal, return the txn hash
 	// The principal can be generated by NewPrincipalWithAccount or NewPrincipalWithGroupId
+	// BuildPutObjectPolicyMsg constructs and validates the putPolicy msg of the object without broadcasting it
+	BuildPutObjectPolicyMsg(bucketName, objectName string, principal types.Principal, statements []*permTypes.Statement, opt types.PutPolicyOption) (*storageTypes.MsgPutPolicy, error)
 	PutObjectPolicy(ctx context.Context, bucketName, objectName string, principal types.Principal,
 		statements []*permTypes.Statement, opt types.PutPolicyOption) (string, error)
 	// DeleteObjectPolicy delete the object policy of the principal, return the txn hash
@@ -66,11 +148,58 @@ type Object interface {
 	// IsObjectPermissionAllowed check if the permission of the object is allowed to the user
 	// userAddr indicates the HEX-encoded string of the user address
 	IsObjectPermissionAllowed(ctx context.Context, userAddr string, bucketName, objectName string, action permTypes.ActionType) (permTypes.Effect, error)
+	// GrantObjectAccess puts the object policy which allows the actions to the grantee until expiry, or forever if
+	// expiry is nil, waits for the tx to be committed and verifies every action is allowed to the grantee, return the
+	// txn hash. It fails with ErrorGrantNotEffective if an action is still not allowed, e.g. it is denied by another
+	// policy of the grantee. granteeAddr indicates the HEX-encoded string of the grantee address
+	GrantObjectAccess(ctx context.Context, bucketName, objectName string, granteeAddr string, actions []permTypes.ActionType, expiry *time.Time) (string, error)
+	// ExplainPermission verifies the permission of the user on the bucket, or on the object if objectName is not empty,
+	// and explains which rule produces the effect, e.g. the visibility, the ownership or the policies of the user
+	ExplainPermission(ctx context.Context, userAddr string, bucketName, objectName string, action permTypes.ActionType) (*types.PermissionExplanation, error)
+	// IsPermissionsAllowed verifies the permissions of the user on multiple resources concurrently and returns the
+	// effect of each resource action
+	IsPermissionsAllowed(ctx context.Context, userAddr string, resources []types.ResourceAction) (map[types.ResourceAction]permTypes.Effect, error)
 	ListObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) (types.ListObjectsResult, error)
+	// ListObjectsIterator return an iterator which lazily lists all the objects of the bucket,
+	// following the continuation token of each page until the listing is exhausted
+	ListObjectsIterator(ctx context.Context, bucketName string, opts types.ListObjectsOptions) *types.Iterator[*types.ObjectMeta]
+	// ListObjectsAtHeight return an iterator which lazily lists the objects of the bucket in the chain state at the
+	// block height, so that the pages are consistent even if the objects change during the iteration. The latest
+	// height is pinned when the first page is fetched if height is 0, and the state of the height must not have
+	// been pruned by the node before the iteration completes.
+	ListObjectsAtHeight(ctx context.Context, bucketName string, height int64) *types.Iterator[*storageTypes.ObjectInfo]
+	// WriteInventory writes the CSV inventory of the objects of the bucket in the chain state at a height, i.e. the
+	// name, size, checksum, creation time and status of each object, for the reconciliation pipelines
+	WriteInventory(ctx context.Context, bucketName string, w io.Writer, opts types.InventoryOptions) (*types.InventoryReport, error)
+	// ExportInventory writes the inventory of the bucket by WriteInventory to a local file or to an object of another
+	// bucket, which is created by the default account
+	ExportInventory(ctx context.Context, bucketName string, dest types.InventoryDestination, opts types.InventoryOptions) (*types.InventoryReport, error)
+	// DownloadPrefix return an iterator of the sealed objects whose names begin with the prefix in the listing order,
+	// the objects are opened by a bounded number of goroutines ahead of the consumer. The consumer should close the
+	// body of every object, and the bodies which are opened but not consumed are closed when the iterator is closed.
+	DownloadPrefix(ctx context.Context, bucketName, prefix string, opts types.DownloadPrefixOptions) *types.Iterator[*types.ObjectDownload]
+	// ListDeletedObjects lists all the objects of the bucket which have been deleted and are still kept by the SP
+	// metadata service, the DeleteAt, DeleteReason and Operator of the returned objects describe the deletions
+	ListDeletedObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) ([]*types.ObjectMeta, error)
+	// ListUnsealedObjects lists all the objects of the bucket which are created but not sealed yet
+	ListUnsealedObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) ([]*types.ObjectMeta, error)
 	// ComputeHashRoots compute the integrity hash, content size and the redundancy type of the file
 	// If isSerial is true, compute the integrity hash using the serial way
 	// If isSerial is false or not provided, compute the integrity hash using the parallel way
+	// The memory is bounded by Option.HashMaxBufferedSegments if it is set
 	ComputeHashRoots(reader io.Reader, isSerial bool) ([][]byte, int64, storageTypes.RedundancyType, error)
+	// ComputeHashRootsWithOptions computes the hash roots like ComputeHashRoots with the redundancy params overridden
+	// by the options, which are validated against the params on chain
+	ComputeHashRootsWithOptions(reader io.Reader, isSerial bool, opts types.ComputeHashOptions) ([][]byte, int64, storageTypes.RedundancyType, error)
+	// ComputeHashRootsWithProgress computes the hash roots like ComputeHashRoots by hashing the segments in parallel,
+	// and calls onProgress after every segment is hashed. size is only used to report the total bytes, it can be zero
+	// if the size is unknown.
+	ComputeHashRootsWithProgress(reader io.Reader, size int64, onProgress types.HashProgressFunc) ([][]byte, int64, storageTypes.RedundancyType, error)
+	// ComputeHashRootsFromFile computes the hash roots of the local file like ComputeHashRootsWithProgress,
+	// onProgress can be nil
+	ComputeHashRootsFromFile(filePath string, onProgress types.HashProgressFunc) ([][]byte, int64, storageTypes.RedundancyType, error)
+	// InvalidateParamsCache drops the cached storage params so that they are queried from chain in the next use
+	InvalidateParamsCache()
 
 	// CreateFolder creates an empty object used as folder.
 	// objectName must ending with a forward slash (/) character
@@ -85,39 +214,77 @@ type Object interface {
 }
 
 // GetRedundancyParams query and return the data shards, parity shards and segment size of redundancy
-// configuration on chain
+// configuration on chain, which are overridden by Option.RedundancyParams
 func (c *client) GetRedundancyParams() (uint32, uint32, uint64, error) {
-	query := storageTypes.QueryParamsRequest{}
-	queryResp, err := c.chainClient.StorageQueryClient.Params(context.Background(), &query)
-	if err != nil {
-		return 0, 0, 0, err
-	}
-
-	versionedParams := queryResp.Params.VersionedParams
-	return versionedParams.GetRedundantDataChunkNum(), versionedParams.GetRedundantParityChunkNum(), versionedParams.GetMaxSegmentSize(), nil
+	return c.resolveRedundancyParams(nil)
 }
 
 // GetParams query and return the data shards, parity shards and segment size of redundancy
-// configuration on chain
+// configuration on chain, the params are served from the cache if ParamsCacheTTL is set and the cache is not expired
 func (c *client) GetParams() (storageTypes.Params, error) {
+	if c.paramsCacheTTL > 0 {
+		c.paramsCacheMutex.Lock()
+		if c.paramsCache != nil && time.Since(c.paramsCacheTime) < c.paramsCacheTTL {
+			params := *c.paramsCache
+			c.paramsCacheMutex.Unlock()
+			return params, nil
+		}
+		// the mutex is not held across the query so that the other callers are not blocked by a slow node
+		c.paramsCacheMutex.Unlock()
+	}
+
 	query := storageTypes.QueryParamsRequest{}
 	queryResp, err := c.chainClient.StorageQueryClient.Params(context.Background(), &query)
 	if err != nil {
 		return storageTypes.Params{}, err
 	}
 
+	if c.paramsCacheTTL > 0 {
+		params := queryResp.Params
+		c.paramsCacheMutex.Lock()
+		c.paramsCache = &params
+		c.paramsCacheTime = time.Now()
+		c.paramsCacheMutex.Unlock()
+	}
 	return queryResp.Params, nil
 }
 
+// InvalidateParamsCache drops the cached storage params
+func (c *client) InvalidateParamsCache() {
+	c.paramsCacheMutex.Lock()
+	defer c.paramsCacheMutex.Unlock()
+	c.paramsCache = nil
+}
+
 // ComputeHashRoots return the integrity hash, content size and the redundancy type of the file
 func (c *client) ComputeHashRoots(reader io.Reader, isSerial bool) ([][]byte, int64, storageTypes.RedundancyType, error) {
-	dataBlocks, parityBlocks, segSize, err := c.GetRedundancyParams()
+	return c.computeHashRoots(reader, isSerial, nil)
+}
+
+// ComputeHashRootsWithOptions overrides the redundancy params of the client by the options
+func (c *client) ComputeHashRootsWithOptions(reader io.Reader, isSerial bool, opts types.ComputeHashOptions) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, err
+	}
+	return c.computeHashRoots(reader, isSerial, &opts)
+}
+
+func (c *client) computeHashRoots(reader io.Reader, isSerial bool, override *types.ComputeHashOptions) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	dataBlocks, parityBlocks, segSize, err := c.resolveRedundancyParams(override)
 	if reader == nil {
 		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, errors.New("fail to compute hash, reader is nil")
 	}
 	if err != nil {
 		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, err
 	}
+	// the segments are streamed through the bounded buffers, a single buffer hashes them serially
+	if c.hashMaxBufferedSegments > 0 {
+		maxBuffers := c.hashMaxBufferedSegments
+		if isSerial {
+			maxBuffers = 1
+		}
+		return computeHashRootsParallel(reader, int64(segSize), int(dataBlocks), int(parityBlocks), 0, nil, maxBuffers)
+	}
 
 	return hashlib.ComputeIntegrityHash(reader, int64(segSize), int(dataBlocks), int(parityBlocks), isSerial)
 }
@@ -127,48 +294,63 @@ func (c *client) ComputeHashRoots(reader io.Reader, isSerial bool) ([][]byte, in
 func (c *client) CreateObject(ctx context.Context, bucketName, objectName string,
 	reader io.Reader, opts types.CreateObjectOptions,
 ) (string, error) {
-	if reader == nil {
-		return "", errors.New("fail to compute hash of payload, reader is nil")
-	}
-
-	if err := s3util.CheckValidBucketName(bucketName); err != nil {
-		return "", err
-	}
-
-	if err := s3util.CheckValidObjectName(objectName); err != nil {
-		return "", err
-	}
-
-	// compute hash root of payload
-	expectCheckSums, size, redundancyType, err := c.ComputeHashRoots(reader, opts.IsSerialComputeMode)
+	createObjectMsg, err := c.newCreateObjectMsg(bucketName, objectName, reader, opts)
 	if err != nil {
 		return "", err
 	}
+	return c.createObjectWithMsg(ctx, bucketName, objectName, createObjectMsg, opts)
+}
 
-	var contentType string
-	if opts.ContentType != "" {
-		contentType = opts.ContentType
-	} else {
-		contentType = types.ContentDefault
-	}
+// createObjectWithMsg gets the approval of the createObject msg and broadcasts it
+func (c *client) createObjectWithMsg(ctx context.Context, bucketName, objectName string,
+	createObjectMsg *storageTypes.MsgCreateObject, opts types.CreateObjectOptions,
+) (string, error) {
+	expectCheckSums := createObjectMsg.ExpectChecksums
 
-	var visibility storageTypes.VisibilityType
-	if opts.Visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
-		visibility = storageTypes.VISIBILITY_TYPE_INHERIT // set default visibility type
-	} else {
-		visibility = opts.Visibility
+	if opts.Idempotent {
+		txnHash, found, err := c.findCreatedObject(ctx, bucketName, objectName, expectCheckSums)
+		if err != nil || found {
+			return txnHash, err
+		}
 	}
 
-	createObjectMsg := storageTypes.NewMsgCreateObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName,
-		uint64(size), visibility, expectCheckSums, contentType, redundancyType, math.MaxUint, nil)
-	err = createObjectMsg.ValidateBasic()
-	if err != nil {
-		return "", err
+	var signedCreateObjectMsg *storageTypes.MsgCreateObject
+	if c.approvalStore != nil {
+		var (
+			stored *types.StoredApproval
+			err    error
+		)
+		if signedCreateObjectMsg, stored, err = c.loadApproval(ctx, createObjectMsg); err != nil {
+			return "", err
+		}
+		// the msg was broadcast before the crash, resume from the tx if it has been committed
+		if stored != nil && stored.TxHash != "" {
+			if txResponse, err := c.chainClient.Tx(ctx, stored.TxHash); err == nil && txResponse.TxResult.Code == 0 {
+				return stored.TxHash, nil
+			}
+			if txnHash, found, err := c.findCreatedObject(ctx, bucketName, objectName, expectCheckSums); err == nil && found {
+				return txnHash, nil
+			}
+		}
 	}
-
-	signedCreateObjectMsg, err := c.GetCreateObjectApproval(ctx, createObjectMsg)
-	if err != nil {
+	if signedCreateObjectMsg == nil {
+		var err error
+		if signedCreateObjectMsg, err = c.GetCreateObjectApproval(ctx, createObjectMsg); err != nil {
+			return "", err
+		}
+		if c.approvalStore != nil {
+			if err = c.saveApproval(signedCreateObjectMsg, ""); err != nil {
+				return "", err
+			}
+		}
+	} else if refreshedMsg, err := c.refreshCreateObjectApproval(ctx, signedCreateObjectMsg); err != nil {
 		return "", err
+	} else if refreshedMsg != signedCreateObjectMsg {
+		// the stored approval expired before the msg was broadcast again
+		signedCreateObjectMsg = refreshedMsg
+		if err = c.saveApproval(signedCreateObjectMsg, ""); err != nil {
+			return "", err
+		}
 	}
 
 	// set the default txn broadcast mode as block mode
@@ -177,8 +359,19 @@ func (c *client) CreateObject(ctx context.Context, bucketName, objectName string
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{signedCreateObjectMsg}, opts.TxOpts)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{signedCreateObjectMsg}, opts.TxOpts)
+	if err == nil && c.approvalStore != nil {
+		if saveErr := c.saveApproval(signedCreateObjectMsg, resp.TxResponse.TxHash); saveErr != nil {
+			log.Warn().Msg(fmt.Sprintf("fail to record the createObject tx of object %s: %s", objectName, saveErr.Error()))
+		}
+	}
 	if err != nil {
+		if opts.Idempotent {
+			// the tx may have landed even if the broadcast failed, e.g. timed out
+			if txnHash, found, findErr := c.findCreatedObject(ctx, bucketName, objectName, expectCheckSums); findErr == nil && found {
+				return txnHash, nil
+			}
+		}
 		return "", err
 	}
 
@@ -189,6 +382,11 @@ func (c *client) CreateObject(ctx context.Context, bucketName, objectName string
 		defer cancel()
 		txnResponse, err = c.WaitForTx(ctxTimeout, txnHash)
 		if err != nil {
+			if opts.Idempotent {
+				if _, found, findErr := c.findCreatedObject(ctx, bucketName, objectName, expectCheckSums); findErr == nil && found {
+					return txnHash, nil
+				}
+			}
 			return txnHash, fmt.Errorf("the transaction has been submitted, please check it later:%v", err)
 		}
 		if txnResponse.TxResult.Code != 0 {
@@ -198,8 +396,183 @@ func (c *client) CreateObject(ctx context.Context, bucketName, objectName string
 	return txnHash, nil
 }
 
+// CreateObjectAndWait sends the createObject txn in async mode and waits for it to be committed
+func (c *client) CreateObjectAndWait(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (*types.TxResult, error) {
+	opts.IsAsyncMode = true
+	txHash, err := c.CreateObject(ctx, bucketName, objectName, reader, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
+// DryRunCreateObject constructs the createObject msg signed by the primary SP and simulates the transaction,
+// the payload of the reader is read to compute the hash roots.
+func (c *client) DryRunCreateObject(ctx context.Context, bucketName, objectName string,
+	reader io.Reader, opts types.CreateObjectOptions,
+) (*types.DryRunResult, error) {
+	signedCreateObjectMsg, err := c.BuildCreateObjectMsg(ctx, bucketName, objectName, reader, opts)
+	if err != nil {
+		return nil, err
+	}
+	var txOpts gnfdsdk.TxOption
+	if opts.TxOpts != nil {
+		txOpts = *opts.TxOpts
+	}
+	return c.DryRunTx(ctx, []sdk.Msg{signedCreateObjectMsg}, txOpts)
+}
+
+// BuildCreateObjectMsg constructs the createObject msg and gets the approval of the primary SP
+func (c *client) BuildCreateObjectMsg(ctx context.Context, bucketName, objectName string,
+	reader io.Reader, opts types.CreateObjectOptions,
+) (*storageTypes.MsgCreateObject, error) {
+	createObjectMsg, err := c.newCreateObjectMsg(bucketName, objectName, reader, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetCreateObjectApproval(ctx, createObjectMsg)
+}
+
+// newCreateObjectMsg computes the hash roots of the payload and constructs the createObject msg
+func (c *client) newCreateObjectMsg(bucketName, objectName string,
+	reader io.Reader, opts types.CreateObjectOptions,
+) (*storageTypes.MsgCreateObject, error) {
+	if reader == nil {
+		return nil, errors.New("fail to compute hash of payload, reader is nil")
+	}
+
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return nil, err
+	}
+
+	if err := utils.VerifyObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	contentType := opts.ContentType
+	if contentType == "" {
+		if opts.DisableContentTypeSniffing {
+			contentType = types.ContentDefault
+		} else {
+			var err error
+			if contentType, reader, err = sniffContentType(objectName, reader); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opts.Compression != types.CompressionNone {
+		compressed, _, err := compressPayload(reader, opts.Compression)
+		if err != nil {
+			return nil, err
+		}
+		defer removeTempFile(compressed)
+		reader = compressed
+		contentType = withContentEncoding(contentType, opts.Compression)
+	}
+
+	// compute hash root of payload
+	var (
+		expectCheckSums [][]byte
+		size            int64
+		redundancyType  storageTypes.RedundancyType
+		err             error
+	)
+	if opts.OnHashProgress != nil {
+		expectCheckSums, size, redundancyType, err = c.ComputeHashRootsWithProgress(reader, 0, opts.OnHashProgress)
+	} else {
+		expectCheckSums, size, redundancyType, err = c.ComputeHashRoots(reader, opts.IsSerialComputeMode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.newCreateObjectMsgWithChecksums(bucketName, objectName, size, expectCheckSums, redundancyType, contentType, opts)
+}
+
+// newCreateObjectMsgWithChecksums constructs the createObject msg of the payload whose checksums have been computed
+func (c *client) newCreateObjectMsgWithChecksums(bucketName, objectName string, size int64, expectCheckSums [][]byte,
+	redundancyType storageTypes.RedundancyType, contentType string, opts types.CreateObjectOptions,
+) (*storageTypes.MsgCreateObject, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	var visibility storageTypes.VisibilityType
+	if opts.Visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storageTypes.VISIBILITY_TYPE_INHERIT // set default visibility type
+	} else {
+		visibility = opts.Visibility
+	}
+
+	createObjectMsg := storageTypes.NewMsgCreateObject(account.GetAddress(), bucketName, objectName,
+		uint64(size), visibility, expectCheckSums, contentType, redundancyType, math.MaxUint, nil)
+	err = createObjectMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return createObjectMsg, nil
+}
+
+// findCreatedObject checks whether the object has been created on chain by the default account with the checksums.
+// It returns the hash of the creating tx if it can be found from the SP metadata service, and ErrorObjectConflict
+// if the object exists with a different owner or content.
+func (c *client) findCreatedObject(ctx context.Context, bucketName, objectName string, checksums [][]byte) (string, bool, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", false, err
+	}
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+	if err != nil {
+		if strings.Contains(err.Error(), storageTypes.ErrNoSuchObject.Error()) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	objectInfo := objectDetail.ObjectInfo
+	if objectInfo.Owner != account.GetAddress().String() || len(objectInfo.Checksums) != len(checksums) {
+		return "", false, types.ErrorObjectConflict
+	}
+	for i := range checksums {
+		if !bytes.Equal(objectInfo.Checksums[i], checksums[i]) {
+			return "", false, types.ErrorObjectConflict
+		}
+	}
+
+	objects, err := c.ListObjectsByObjectID(ctx, []uint64{objectInfo.Id.Uint64()}, types.EndPointOptions{})
+	if err != nil {
+		log.Debug().Msg(fmt.Sprintf("fail to get the create tx of object %s: %s", objectName, err.Error()))
+		return "", true, nil
+	}
+	if objectMeta, ok := objects.Objects[objectInfo.Id.Uint64()]; ok && objectMeta != nil {
+		return objectMeta.CreateTxHash, true, nil
+	}
+	return "", true, nil
+}
+
+// GetObjectIDFromTx returns the object id assigned by the CreateObject transaction of the hash
+func (c *client) GetObjectIDFromTx(ctx context.Context, txHash string) (storageTypes.Uint, error) {
+	txEvents, err := c.GetTxEvents(ctx, txHash)
+	if err != nil {
+		return storageTypes.Uint{}, err
+	}
+	event, ok := events.FindEvent[*storageTypes.EventCreateObject](txEvents)
+	if !ok {
+		return storageTypes.Uint{}, types.ErrorEventNotFound
+	}
+	return event.ObjectId, nil
+}
+
 // DeleteObject send DeleteBucket txn to greenfield chain and return txn hash
 func (c *client) DeleteObject(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
@@ -208,12 +581,188 @@ func (c *client) DeleteObject(ctx context.Context, bucketName, objectName string
 		return "", err
 	}
 
-	delObjectMsg := storageTypes.NewMsgDeleteObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName)
+	if err := c.checkDeleteProtection(bucketName+"/"+objectName, opt.Force); err != nil {
+		return "", err
+	}
+
+	delObjectMsg := storageTypes.NewMsgDeleteObject(account.GetAddress(), bucketName, objectName)
 	return c.sendTxn(ctx, delObjectMsg, opt.TxOpts)
 }
 
+// DeleteObjectAndWait sends the DeleteObject txn and waits for it to be committed
+func (c *client) DeleteObjectAndWait(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (*types.TxResult, error) {
+	txHash, err := c.DeleteObject(ctx, bucketName, objectName, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
+}
+
+// DeleteObjects deletes the objects in batched transactions of at most opts.BatchSize msgs.
+// It returns the objects deleted so far together with the error if any batch fails.
+func (c *client) DeleteObjects(ctx context.Context, bucketName string, objectNames []string, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	if err := opts.Validate(); err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	msgs := make([]sdk.Msg, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		if err := s3util.CheckValidObjectName(objectName); err != nil {
+			return types.DeleteObjectsResult{}, err
+		}
+		if err := c.checkDeleteProtection(bucketName+"/"+objectName, opts.Force); err != nil {
+			return types.DeleteObjectsResult{}, err
+		}
+		msgs = append(msgs, storageTypes.NewMsgDeleteObject(account.GetAddress(), bucketName, objectName))
+	}
+	return c.deleteObjectsInBatch(ctx, objectNames, msgs, opts)
+}
+
+// DeleteObjectsByPrefix deletes the objects whose names begin with the prefix.
+// The objects which are created but not sealed are canceled rather than deleted.
+func (c *client) DeleteObjectsByPrefix(ctx context.Context, bucketName, prefix string, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	if prefix == "" {
+		return types.DeleteObjectsResult{}, errors.New("prefix is empty, use DeleteBucket or DeleteObjects instead")
+	}
+
+	iter := c.ListObjectsIterator(ctx, bucketName, types.ListObjectsOptions{Prefix: prefix, EndPointOptions: opts.EndPointOptions})
+	defer iter.Close()
+
+	var (
+		objectNames []string
+		msgs        []sdk.Msg
+	)
+	operator := account.GetAddress()
+	for iter.Next() {
+		objectInfo := iter.Value().ObjectInfo
+		if objectInfo == nil {
+			continue
+		}
+		if err := c.checkDeleteProtection(bucketName+"/"+objectInfo.ObjectName, opts.Force); err != nil {
+			return types.DeleteObjectsResult{}, err
+		}
+		objectNames = append(objectNames, objectInfo.ObjectName)
+		if objectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
+			msgs = append(msgs, storageTypes.NewMsgCancelCreateObject(operator, bucketName, objectInfo.ObjectName))
+		} else {
+			msgs = append(msgs, storageTypes.NewMsgDeleteObject(operator, bucketName, objectInfo.ObjectName))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	return c.deleteObjectsInBatch(ctx, objectNames, msgs, opts)
+}
+
+// CancelStaleCreates compares the creation time of the objects with the local clock, the objects are canceled by the
+// default account, so the objects created by other accounts are only canceled if it has the permission
+func (c *client) CancelStaleCreates(ctx context.Context, bucketName string, olderThan time.Duration, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+	if olderThan < 0 {
+		return types.DeleteObjectsResult{}, fmt.Errorf("%w: olderThan should not be negative", types.ErrorInvalidOption)
+	}
+	unsealedObjects, err := c.ListUnsealedObjects(ctx, bucketName, types.ListObjectsOptions{EndPointOptions: opts.EndPointOptions})
+	if err != nil {
+		return types.DeleteObjectsResult{}, err
+	}
+
+	var (
+		objectNames []string
+		msgs        []sdk.Msg
+	)
+	deadline := c.now().Add(-olderThan).Unix()
+	operator := account.GetAddress()
+	for _, objectMeta := range unsealedObjects {
+		if objectMeta.ObjectInfo.CreateAt > deadline {
+			continue
+		}
+		objectNames = append(objectNames, objectMeta.ObjectInfo.ObjectName)
+		msgs = append(msgs, storageTypes.NewMsgCancelCreateObject(operator, bucketName, objectMeta.ObjectInfo.ObjectName))
+	}
+	result, err := c.deleteObjectsInBatch(ctx, objectNames, msgs, opts)
+	if !opts.DryRun {
+		for _, objectName := range result.ObjectNames {
+			c.forgetApproval(bucketName, objectName)
+		}
+	}
+	return result, err
+}
+
+// deleteObjectsInBatch sends the msgs of deleting the objects in batched transactions
+func (c *client) deleteObjectsInBatch(ctx context.Context, objectNames []string, msgs []sdk.Msg, opts types.DeleteObjectsOptions) (types.DeleteObjectsResult, error) {
+	if opts.DryRun {
+		return types.DeleteObjectsResult{ObjectNames: objectNames}, nil
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = types.DefaultDeleteObjectsBatchSize
+	}
+
+	result := types.DeleteObjectsResult{}
+	txOpt := opts.TxOpts
+	for start := 0; start < len(msgs); start += batchSize {
+		if start > 0 && opts.BatchInterval > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(opts.BatchInterval):
+			}
+		}
+		end := start + batchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		resp, err := c.broadcastTx(ctx, msgs[start:end], txOpt)
+		if err != nil {
+			return result, err
+		}
+		txnHash := resp.TxResponse.TxHash
+		if resp.TxResponse.Code != 0 {
+			return result, fmt.Errorf("the delete objects txn %s is rejected with response code: %d, log: %s", txnHash, resp.TxResponse.Code, resp.TxResponse.RawLog)
+		}
+		// wait for the batch to be committed, so that the nonce of next batch is correct
+		ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+		txnResponse, err := c.WaitForTx(ctxTimeout, txnHash)
+		cancel()
+		if err != nil {
+			return result, fmt.Errorf("the transaction %s has been submitted, please check it later: %w", txnHash, err)
+		}
+		if txnResponse.TxResult.Code != 0 {
+			return result, fmt.Errorf("the delete objects txn %s has failed with response code: %d", txnHash, txnResponse.TxResult.Code)
+		}
+		result.ObjectNames = append(result.ObjectNames, objectNames[start:end]...)
+		result.TxHashes = append(result.TxHashes, txnHash)
+
+		// the nonce set by the caller is taken by the first batch, the next batch takes the following one
+		if txOpt != nil && txOpt.Nonce != 0 {
+			nextTxOpt := *txOpt
+			nextTxOpt.Nonce++
+			txOpt = &nextTxOpt
+		}
+	}
+	return result, nil
+}
+
 // CancelCreateObject send CancelCreateObject txn to greenfield chain
 func (c *client) CancelCreateObject(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
@@ -222,8 +771,21 @@ func (c *client) CancelCreateObject(ctx context.Context, bucketName, objectName
 		return "", err
 	}
 
-	cancelCreateMsg := storageTypes.NewMsgCancelCreateObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName)
-	return c.sendTxn(ctx, cancelCreateMsg, opt.TxOpts)
+	cancelCreateMsg := storageTypes.NewMsgCancelCreateObject(account.GetAddress(), bucketName, objectName)
+	txnHash, err := c.sendTxn(ctx, cancelCreateMsg, opt.TxOpts)
+	if err == nil {
+		c.forgetApproval(bucketName, objectName)
+	}
+	return txnHash, err
+}
+
+// CancelCreateObjectAndWait sends the CancelCreateObject txn and waits for it to be committed
+func (c *client) CancelCreateObjectAndWait(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (*types.TxResult, error) {
+	txHash, err := c.CancelCreateObject(ctx, bucketName, objectName, opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTxResult(ctx, txHash)
 }
 
 // PutObject supports the second stage of uploading the object to bucket.
@@ -234,6 +796,25 @@ func (c *client) PutObject(ctx context.Context, bucketName, objectName string, o
 	if objectSize <= 0 {
 		return errors.New("object size should be more than 0")
 	}
+	if err = opts.Validate(); err != nil {
+		return err
+	}
+
+	if opts.ContentType == "" && !opts.DisableContentTypeSniffing {
+		if opts.ContentType, reader, err = sniffContentType(objectName, reader); err != nil {
+			return err
+		}
+	}
+
+	if opts.Compression != types.CompressionNone {
+		compressed, compressedSize, err := compressPayload(reader, opts.Compression)
+		if err != nil {
+			return err
+		}
+		defer removeTempFile(compressed)
+		reader, objectSize = compressed, compressedSize
+		opts.ContentType = withContentEncoding(opts.ContentType, opts.Compression)
+	}
 
 	params, err := c.GetParams()
 	if err != nil {
@@ -249,11 +830,15 @@ func (c *client) PutObject(ctx context.Context, bucketName, objectName string, o
 
 	// upload an entire object to the storage provider in a single request
 	if objectSize <= int64(opts.PartSize) || opts.DisableResumable {
-		return c.putObject(ctx, bucketName, objectName, objectSize, reader, opts)
+		err = c.putObject(ctx, bucketName, objectName, objectSize, reader, opts)
+	} else {
+		// resumableupload
+		err = c.putObjectResumable(ctx, bucketName, objectName, objectSize, reader, opts)
 	}
-
-	// resumableupload
-	return c.putObjectResumable(ctx, bucketName, objectName, objectSize, reader, opts)
+	if err == nil {
+		c.forgetApproval(bucketName, objectName)
+	}
+	return err
 }
 
 func (c *client) putObject(ctx context.Context, bucketName, objectName string, objectSize int64,
@@ -271,12 +856,18 @@ func (c *client) putObject(ctx context.Context, bucketName, objectName string, o
 		contentType = types.ContentDefault
 	}
 
+	reader, rewind, err := newRetryableBody(reader, objectSize, opts.DisableRetryBuffering)
+	if err != nil {
+		return err
+	}
+
 	reqMeta := requestMeta{
 		bucketName:    bucketName,
 		objectName:    objectName,
 		contentSHA256: types.EmptyStringSHA256,
 		contentLength: objectSize,
 		contentType:   contentType,
+		userMetadata:  opts.UserMetadata,
 	}
 
 	var sendOpt sendOptions
@@ -292,6 +883,7 @@ func (c *client) putObject(ctx context.Context, bucketName, objectName string, o
 			body:   reader,
 		}
 	}
+	sendOpt.timeout = opts.Timeout
 
 	endpoint, err := c.getSPUrlByBucket(bucketName)
 	if err != nil {
@@ -299,12 +891,132 @@ func (c *client) putObject(ctx context.Context, bucketName, objectName string, o
 		return err
 	}
 
-	_, err = c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
+	return c.sendUploadReq(ctx, reqMeta, &sendOpt, endpoint, rewind)
+}
+
+// SignUploadPermit signs the upload request of the object payload with the default account.
+// The object should have been created on chain, the payload is sent by the holder of the permit
+// with the HTTP method, url and headers of the permit.
+func (c *client) SignUploadPermit(ctx context.Context, bucketName, objectName string, objectSize int64, opts types.PutObjectOptions) (*types.UploadPermit, error) {
+	account, err := c.signingAccount()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3util.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if c.offChainAuthOption != nil {
+		return nil, errors.New("upload permit is not supported in off-chain-auth mode")
 	}
 
-	return nil
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = types.ContentDefault
+	}
+	reqMeta := requestMeta{
+		bucketName:    bucketName,
+		objectName:    objectName,
+		contentSHA256: types.EmptyStringSHA256,
+		contentLength: objectSize,
+		contentType:   contentType,
+		userMetadata:  opts.UserMetadata,
+	}
+
+	endpoint, err := c.getSPUrlByBucket(bucketName)
+	if err != nil {
+		log.Error().Msg(fmt.Sprintf("route endpoint by bucket: %s failed, err: %s", bucketName, err.Error()))
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, reqMeta, nil, opts.TxnHash, false, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	expiresTime, err := time.Parse(types.Iso8601DateFormatSecond, req.Header.Get(httplib.HTTPHeaderExpiryTimestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		headers[key] = req.Header.Get(key)
+	}
+	return &types.UploadPermit{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Host:        req.Host,
+		Headers:     headers,
+		BucketName:  bucketName,
+		ObjectName:  objectName,
+		ObjectSize:  objectSize,
+		Signer:      account.GetAddress().String(),
+		ExpiresTime: expiresTime,
+	}, nil
+}
+
+// VerifyUploadPermit checks that the upload permit is not expired and is signed by its signer.
+// It does not access the chain or the SP and returns the address recovered from the signature.
+func VerifyUploadPermit(permit *types.UploadPermit) (sdk.AccAddress, error) {
+	if permit == nil {
+		return nil, errors.New("upload permit is empty")
+	}
+	if time.Now().After(permit.ExpiresTime) {
+		return nil, fmt.Errorf("upload permit expired at %s", permit.ExpiresTime.String())
+	}
+
+	req, err := http.NewRequest(permit.Method, permit.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Host = permit.Host
+	for key, value := range permit.Headers {
+		req.Header.Set(key, value)
+	}
+	if req.Header.Get(httplib.HTTPHeaderExpiryTimestamp) != permit.ExpiresTime.UTC().Format(types.Iso8601DateFormatSecond) {
+		return nil, errors.New("expiry time of upload permit does not match the signed header")
+	}
+
+	authStr := req.Header.Get(types.HTTPHeaderAuthorization)
+	req.Header.Del(types.HTTPHeaderAuthorization)
+	prefix := httplib.Gnfd1Ecdsa + ", Signature="
+	if !strings.HasPrefix(authStr, prefix) {
+		return nil, errors.New("upload permit is not signed in " + httplib.Gnfd1Ecdsa)
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(authStr, prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := ethcrypto.SigToPub(httplib.GetMsgToSignInGNFD1Auth(req), signature)
+	if err != nil {
+		return nil, err
+	}
+	signer := sdk.AccAddress(ethcrypto.PubkeyToAddress(*pubKey).Bytes())
+	if permit.Signer != "" && !strings.EqualFold(signer.String(), permit.Signer) {
+		return nil, fmt.Errorf("upload permit is signed by %s rather than %s", signer.String(), permit.Signer)
+	}
+	return signer, nil
+}
+
+// sniffContentType detects the content type of the object by its name extension and the leading bytes of
+// the payload, it returns the reader which still yields the whole payload
+func sniffContentType(objectName string, reader io.Reader) (string, io.Reader, error) {
+	head := make([]byte, types.ContentSniffLength)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", reader, err
+	}
+	head = head[:n]
+	// the seeker is kept, so that the payload can be rewound for re-sending it
+	if seeker, ok := reader.(io.Seeker); ok {
+		if _, err = seeker.Seek(-int64(n), io.SeekCurrent); err == nil {
+			return utils.DetectContentType(objectName, head), reader, nil
+		}
+	}
+	return utils.DetectContentType(objectName, head), io.MultiReader(bytes.NewReader(head), reader), nil
 }
 
 // UploadSegmentHook is for testing usage
@@ -399,6 +1111,7 @@ func (c *client) putObjectResumable(ctx context.Context, bucketName, objectName
 			contentLength: int64(length),
 			contentType:   contentType,
 			urlValues:     urlValues,
+			userMetadata:  opts.UserMetadata,
 		}
 
 		var sendOpt sendOptions
@@ -414,6 +1127,7 @@ func (c *client) putObjectResumable(ctx context.Context, bucketName, objectName
 				body:   rd,
 			}
 		}
+		sendOpt.timeout = opts.Timeout
 
 		endpoint, err := c.getSPUrlByBucket(bucketName)
 		if err != nil {
@@ -421,8 +1135,11 @@ func (c *client) putObjectResumable(ctx context.Context, bucketName, objectName
 			return err
 		}
 
-		// Proceed to upload the part.
-		_, err = c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
+		// Proceed to upload the part, which is re-sent from the buffer on failure.
+		err = c.sendUploadReq(ctx, reqMeta, &sendOpt, endpoint, func() error {
+			_, err := rd.Seek(0, io.SeekStart)
+			return err
+		})
 		if err != nil {
 			return err
 		}
@@ -440,61 +1157,510 @@ func (c *client) putObjectResumable(ctx context.Context, bucketName, objectName
 		}
 	}
 
-	return nil
-}
+	return nil
+}
+
+func (c *client) headSPObjectInfo(ctx context.Context, bucketName, objectName string) error {
+	backoffDelay := types.HeadBackOffDelay
+	for retry := 0; retry < types.MaxHeadTryTime; retry++ {
+		_, err := c.getObjectStatusFromSP(ctx, bucketName, objectName)
+		if err == nil {
+			return nil
+		}
+		// if the error is not "no such object", ignore it
+		if !strings.Contains(strings.ToLower(err.Error()), types.NoSuchObjectErr) {
+			return nil
+		}
+
+		if retry == types.MaxHeadTryTime-1 {
+			return fmt.Errorf(" sp failed to head info of the object: %s, please try putObject later", objectName)
+		}
+
+		time.Sleep(backoffDelay)
+		backoffDelay *= 2
+	}
+
+	return nil
+}
+
+// FPutObject supports uploading object from local file
+func (c *client) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectOptions) (err error) {
+	fReader, err := os.Open(filePath)
+	// If any error fail quickly here.
+	if err != nil {
+		return err
+	}
+	defer fReader.Close()
+
+	// Save the file stat.
+	stat, err := fReader.Stat()
+	if err != nil {
+		return err
+	}
+
+	if opts.ContentType == "" && !opts.DisableContentTypeSniffing {
+		opts.ContentType = mime.TypeByExtension(filepath.Ext(filePath))
+	}
+
+	return c.PutObject(ctx, bucketName, objectName, stat.Size(), fReader, opts)
+}
+
+// PutObjectFromFile creates the object, uploads the payload from the local file and waits for the object to be sealed.
+// The file is read twice with a buffered reader, once to compute the hash roots and once to upload it.
+// It returns the hash of the createObject transaction.
+func (c *client) PutObjectFromFile(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectFromFileOptions) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	if stat.IsDir() {
+		return "", fmt.Errorf("%s is a directory", filePath)
+	}
+
+	if opts.CreateOpts.ContentType == "" {
+		opts.CreateOpts.ContentType = mime.TypeByExtension(filepath.Ext(filePath))
+	}
+	if opts.PutOpts.ContentType == "" {
+		opts.PutOpts.ContentType = opts.CreateOpts.ContentType
+	}
+	if opts.PutOpts.Compression == types.CompressionNone {
+		opts.PutOpts.Compression = opts.CreateOpts.Compression
+	}
+
+	txnHash, err := c.CreateObject(ctx, bucketName, objectName, bufio.NewReaderSize(file, types.FileReadBufferSize), opts.CreateOpts)
+	if err != nil {
+		return txnHash, err
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return txnHash, err
+	}
+	opts.PutOpts.TxnHash = txnHash
+	if err = c.PutObject(ctx, bucketName, objectName, stat.Size(), bufio.NewReaderSize(file, types.FileReadBufferSize), opts.PutOpts); err != nil {
+		return txnHash, err
+	}
+
+	if opts.DisableWaitSeal {
+		return txnHash, nil
+	}
+	return txnHash, c.waitForObjectSealed(ctx, bucketName, objectName, opts.SealTimeout)
+}
+
+// UploadStream spools the stream segment by segment to the temp directory while computing the checksums, creates the
+// object with the final size and uploads the spooled segments
+func (c *client) UploadStream(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.SpoolOptions) (string, error) {
+	if reader == nil {
+		return "", errors.New("the stream reader is nil")
+	}
+	if opts.CreateOpts.Compression != types.CompressionNone || opts.PutOpts.Compression != types.CompressionNone {
+		return "", errors.New("compression is not supported by UploadStream")
+	}
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return "", err
+	}
+	if err := utils.VerifyObjectName(objectName); err != nil {
+		return "", err
+	}
+
+	contentType := opts.CreateOpts.ContentType
+	if contentType == "" {
+		if opts.CreateOpts.DisableContentTypeSniffing {
+			contentType = types.ContentDefault
+		} else {
+			var err error
+			if contentType, reader, err = sniffContentType(objectName, reader); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	dataBlocks, parityBlocks, segSize, err := c.GetRedundancyParams()
+	if err != nil {
+		return "", err
+	}
+	spoolDir, err := os.MkdirTemp(opts.SpoolDir, "gnfd-spool-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(spoolDir)
+
+	segmentFiles, err := spoolSegments(ctx, reader, spoolDir, int64(segSize), opts.MaxSize, hashlib.NewHasher(int64(segSize), int(dataBlocks), int(parityBlocks)))
+	if err != nil {
+		return "", err
+	}
+
+	createObjectMsg, err := c.newCreateObjectMsgWithChecksums(bucketName, objectName, segmentFiles.size, segmentFiles.checksums,
+		segmentFiles.redundancyType, contentType, opts.CreateOpts)
+	if err != nil {
+		return "", err
+	}
+	txnHash, err := c.createObjectWithMsg(ctx, bucketName, objectName, createObjectMsg, opts.CreateOpts)
+	if err != nil {
+		return txnHash, err
+	}
+	// the empty object is sealed once created
+	if segmentFiles.size == 0 {
+		return txnHash, nil
+	}
+
+	opts.PutOpts.TxnHash = txnHash
+	opts.PutOpts.ContentType = contentType
+	segmentReader := &segmentFilesReader{paths: segmentFiles.paths}
+	defer segmentReader.Close()
+	return txnHash, c.PutObject(ctx, bucketName, objectName, segmentFiles.size, segmentReader, opts.PutOpts)
+}
+
+// spooledSegments is the result of spooling a stream
+type spooledSegments struct {
+	paths          []string
+	size           int64
+	checksums      [][]byte
+	redundancyType storageTypes.RedundancyType
+}
+
+// spoolSegments writes the stream into the segment files of spoolDir and feeds the segments to the hasher
+func spoolSegments(ctx context.Context, reader io.Reader, spoolDir string, segSize, maxSize int64,
+	hasher *hashlib.IntegrityHasher,
+) (*spooledSegments, error) {
+	hasher.Init()
+	result := &spooledSegments{}
+	buf := make([]byte, segSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, readErr := io.ReadFull(reader, buf)
+		if n > 0 {
+			result.size += int64(n)
+			if maxSize > 0 && result.size > maxSize {
+				return nil, fmt.Errorf("the stream exceeds the max size %d", maxSize)
+			}
+			segmentPath := filepath.Join(spoolDir, fmt.Sprintf("segment-%08d", len(result.paths)))
+			if err := os.WriteFile(segmentPath, buf[:n], types.FilePermMode); err != nil {
+				return nil, err
+			}
+			result.paths = append(result.paths, segmentPath)
+			if err := hasher.Append(buf[:n]); err != nil {
+				return nil, err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	var err error
+	result.checksums, _, result.redundancyType, err = hasher.Finish()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// segmentFilesReader reads the spooled segment files in order, only one file is opened at a time
+type segmentFilesReader struct {
+	paths   []string
+	current *os.File
+}
+
+func (r *segmentFilesReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.paths) == 0 {
+				return 0, io.EOF
+			}
+			fd, err := os.Open(r.paths[0])
+			if err != nil {
+				return 0, err
+			}
+			r.current, r.paths = fd, r.paths[1:]
+		}
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *segmentFilesReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
+
+// WatchObject queries the object status on chain and delivers the transitions. The current status is delivered
+// first if the object exists, otherwise the first event is delivered once the object is created.
+// If the client uses the websocket connection, the status is queried again on every create, seal, reject, cancel
+// and delete event of the object, otherwise it is polled every opts.PollInterval.
+func (c *client) WatchObject(ctx context.Context, bucketName, objectName string, opts types.WatchObjectOptions) (<-chan types.ObjectStatusEvent, error) {
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3util.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	// subscribe before the first query, so that no transition is missed
+	var (
+		changed      chan struct{}
+		unsubscribes []func()
+	)
+	if c.chainEvents != nil {
+		changed = make(chan struct{}, 1)
+		for _, eventType := range objectEventTypes {
+			unsubscribe, err := c.chainEvents.subscribe(ctx, objectEventQuery(eventType, bucketName), func(typedEvents []proto.Message) {
+				if !isObjectEvent(typedEvents, bucketName, objectName) {
+					return
+				}
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			})
+			if err != nil {
+				for _, unsubscribe := range unsubscribes {
+					unsubscribe()
+				}
+				return nil, err
+			}
+			unsubscribes = append(unsubscribes, unsubscribe)
+		}
+	}
+
+	eventCh := make(chan types.ObjectStatusEvent)
+	go func() {
+		defer close(eventCh)
+		defer func() {
+			for _, unsubscribe := range unsubscribes {
+				unsubscribe()
+			}
+		}()
+		// the status is polled without the websocket connection
+		var tick <-chan time.Time
+		if changed == nil {
+			interval := opts.PollInterval
+			if interval <= 0 {
+				interval = types.WatchObjectPollInterval
+			}
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+
+		var (
+			seen       bool
+			lastStatus storageTypes.ObjectStatus
+		)
+		for {
+			var event *types.ObjectStatusEvent
+			objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+			switch {
+			case err == nil:
+				if !seen || objectDetail.ObjectInfo.ObjectStatus != lastStatus {
+					seen, lastStatus = true, objectDetail.ObjectInfo.ObjectStatus
+					event = &types.ObjectStatusEvent{Status: lastStatus, ObjectInfo: objectDetail.ObjectInfo, Time: time.Now()}
+				}
+			case strings.Contains(err.Error(), storageTypes.ErrNoSuchObject.Error()):
+				if seen {
+					event = &types.ObjectStatusEvent{Deleted: true, Time: time.Now()}
+				}
+			default:
+				log.Debug().Msgf("fail to query the status of object %s when watching, err: %s", objectName, err.Error())
+			}
+
+			if event != nil {
+				select {
+				case eventCh <- *event:
+				case <-ctx.Done():
+					return
+				}
+				if event.Deleted {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.closeCtx.Done():
+				return
+			case <-tick:
+			case <-changed:
+			}
+		}
+	}()
+	return eventCh, nil
+}
+
+// waitForObjectSealed polls the object status on chain until the object is sealed or the timeout is reached
+func (c *client) waitForObjectSealed(ctx context.Context, bucketName, objectName string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = types.WaitSealTimeout
+	}
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(types.WaitSealInterval)
+	defer ticker.Stop()
+	for {
+		objectDetail, err := c.HeadObject(ctxTimeout, bucketName, objectName)
+		if err == nil && objectDetail.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED {
+			return nil
+		}
+		select {
+		case <-ctxTimeout.Done():
+			return fmt.Errorf("object %s is not sealed in %s: %v", objectName, timeout, ctxTimeout.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetObject download s3 object payload and return the related object info
+func (c *client) GetObject(ctx context.Context, bucketName, objectName string,
+	opts types.GetObjectOptions,
+) (io.ReadCloser, types.ObjectStat, error) {
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+
+	if err := s3util.CheckValidObjectName(objectName); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
 
-func (c *client) headSPObjectInfo(ctx context.Context, bucketName, objectName string) error {
-	backoffDelay := types.HeadBackOffDelay
-	for retry := 0; retry < types.MaxHeadTryTime; retry++ {
-		_, err := c.getObjectStatusFromSP(ctx, bucketName, objectName)
-		if err == nil {
-			return nil
-		}
-		// if the error is not "no such object", ignore it
-		if !strings.Contains(strings.ToLower(err.Error()), types.NoSuchObjectErr) {
-			return nil
-		}
+	var (
+		body    io.ReadCloser
+		objStat types.ObjectStat
+		err     error
+	)
+	if c.objectCache != nil && opts.Range == "" && !opts.DisableCache {
+		body, objStat, err = c.getObjectThroughCache(ctx, bucketName, objectName, opts)
+	} else {
+		body, objStat, err = c.getObjectPayload(ctx, bucketName, objectName, opts)
+	}
+	if err != nil {
+		return nil, types.ObjectStat{}, err
+	}
 
-		if retry == types.MaxHeadTryTime-1 {
-			return fmt.Errorf(" sp failed to head info of the object: %s, please try putObject later", objectName)
+	contentType, encoding := types.ParseContentEncoding(objStat.ContentType)
+	objStat.ContentEncoding = encoding
+	if encoding != types.CompressionNone && opts.Range == "" && !opts.DisableDecompression {
+		decompressed, err := newDecompressReader(body, encoding)
+		if err != nil {
+			body.Close()
+			return nil, types.ObjectStat{}, err
 		}
-
-		time.Sleep(backoffDelay)
-		backoffDelay *= 2
+		objStat.ContentType, objStat.Size = contentType, -1
+		return decompressed, objStat, nil
 	}
 
-	return nil
+	return body, objStat, nil
 }
 
-// FPutObject supports uploading object from local file
-func (c *client) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectOptions) (err error) {
-	fReader, err := os.Open(filePath)
-	// If any error fail quickly here.
+// guardReadQuota compares the size to be downloaded with the remaining read quota of the bucket, and purchases the
+// shortfall or calls OnInsufficient according to the guard options if the quota is insufficient
+func (c *client) guardReadQuota(ctx context.Context, bucketName, objectName, rangeStr string, guard *types.QuotaGuardOptions) error {
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return err
 	}
-	defer fReader.Close()
+	required := objectDetail.ObjectInfo.GetPayloadSize()
+	if isRange, start, end := utils.ParseRange(rangeStr); isRange && uint64(start) < required {
+		if end < 0 || uint64(end) >= required {
+			end = int64(required) - 1
+		}
+		required = uint64(end - start + 1)
+	}
 
-	// Save the file stat.
-	stat, err := fReader.Stat()
+	quota, err := c.GetBucketQuotaSummary(ctx, bucketName)
 	if err != nil {
 		return err
 	}
+	remaining := quota.RemainingQuota()
+	if required <= remaining {
+		return nil
+	}
 
-	return c.PutObject(ctx, bucketName, objectName, stat.Size(), fReader, opts)
+	if guard.AutoPurchase {
+		txnHash, err := c.BuyQuotaForBucket(ctx, bucketName, quota.ChargedQuota+required-remaining, types.BuyQuotaOption{TxOpts: guard.TxOpts})
+		if err != nil {
+			return fmt.Errorf("failed to purchase the read quota of bucket %s: %w", bucketName, err)
+		}
+		ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+		defer cancel()
+		txnResponse, err := c.WaitForTx(ctxTimeout, txnHash)
+		if err != nil {
+			return fmt.Errorf("the quota purchasing txn %s has been submitted, please check it later: %w", txnHash, err)
+		}
+		if txnResponse.TxResult.Code != 0 {
+			return fmt.Errorf("the quota purchasing txn %s has failed with response code: %d", txnHash, txnResponse.TxResult.Code)
+		}
+		return nil
+	}
+	if guard.OnInsufficient != nil {
+		return guard.OnInsufficient(bucketName, required, remaining)
+	}
+	return fmt.Errorf("%w: %d bytes are required to download %s/%s, but %d bytes remain",
+		types.ErrorInsufficientReadQuota, required, bucketName, objectName, remaining)
 }
 
-// GetObject download s3 object payload and return the related object info
-func (c *client) GetObject(ctx context.Context, bucketName, objectName string,
+// getObjectThroughCache returns the payload of the sealed object from the disk cache, or downloads it and fills the
+// cache while it is read
+func (c *client) getObjectThroughCache(ctx context.Context, bucketName, objectName string,
 	opts types.GetObjectOptions,
 ) (io.ReadCloser, types.ObjectStat, error) {
-	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+	if err != nil {
 		return nil, types.ObjectStat{}, err
 	}
+	objectInfo := objectDetail.ObjectInfo
+	if objectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED || len(objectInfo.Checksums) == 0 {
+		return c.getObjectPayload(ctx, bucketName, objectName, opts)
+	}
 
-	if err := s3util.CheckValidObjectName(objectName); err != nil {
+	key := objectCacheKey(objectInfo.Id.String(), objectInfo.Checksums[0])
+	if fd, ok := c.objectCache.get(key); ok {
+		contentType := objectInfo.ContentType
+		if contentType == "" {
+			contentType = types.ContentDefault
+		}
+		return fd, types.ObjectStat{ObjectName: objectName, ContentType: contentType, Size: int64(objectInfo.PayloadSize)}, nil
+	}
+
+	body, objStat, err := c.getObjectPayload(ctx, bucketName, objectName, opts)
+	if err != nil {
 		return nil, types.ObjectStat{}, err
 	}
+	return c.objectCache.fill(key, body, int64(objectInfo.PayloadSize)), objStat, nil
+}
+
+// getObjectPayload downloads the stored payload of the object from the primary SP
+func (c *client) getObjectPayload(ctx context.Context, bucketName, objectName string,
+	opts types.GetObjectOptions,
+) (io.ReadCloser, types.ObjectStat, error) {
+	if opts.QuotaGuard != nil {
+		if err := c.guardReadQuota(ctx, bucketName, objectName, opts.Range, opts.QuotaGuard); err != nil {
+			return nil, types.ObjectStat{}, err
+		}
+	}
 
 	reqMeta := requestMeta{
 		bucketName:    bucketName,
@@ -509,6 +1675,7 @@ func (c *client) GetObject(ctx context.Context, bucketName, objectName string,
 	sendOpt := sendOptions{
 		method:           http.MethodGet,
 		disableCloseBody: true,
+		timeout:          opts.Timeout,
 	}
 
 	endpoint, err := c.getSPUrlByBucket(bucketName)
@@ -519,6 +1686,9 @@ func (c *client) GetObject(ctx context.Context, bucketName, objectName string,
 
 	resp, err := c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
 	if err != nil {
+		if c.loadDefaultAccount() == nil && isAccessDeniedErr(err) {
+			return nil, types.ObjectStat{}, fmt.Errorf("%w, only the public-read objects can be downloaded anonymously", err)
+		}
 		return nil, types.ObjectStat{}, err
 	}
 
@@ -531,7 +1701,9 @@ func (c *client) GetObject(ctx context.Context, bucketName, objectName string,
 	return resp.Body, objStat, nil
 }
 
-// FGetObject download s3 object payload adn write the object content into local file specified by filePath
+// FGetObject downloads the object payload into a temp file and renames it to the local file specified by filePath
+// once the download completes. If the temp file of a previous interrupted download exists, the download is resumed
+// from the end of it. The checksum of the payload is verified if the whole object is downloaded.
 func (c *client) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error {
 	// Verify if destination already exists.
 	st, err := os.Stat(filePath)
@@ -543,23 +1715,109 @@ func (c *client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		return errors.New("download file already exist")
 	}
 
-	fd, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o660)
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return err
 	}
+	payloadSize := int64(objectDetail.ObjectInfo.GetPayloadSize())
 
-	body, _, err := c.GetObject(ctx, bucketName, objectName, opts)
+	tempFilePath := filePath + types.TempFileSuffix
+	fd, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_RDWR, types.FilePermMode)
 	if err != nil {
 		return err
 	}
-	defer body.Close()
+	defer fd.Close()
 
-	_, err = io.Copy(fd, body)
-	fd.Close()
+	tempStat, err := fd.Stat()
 	if err != nil {
 		return err
 	}
+	offset := tempStat.Size()
+	// the range download and the stale temp file are not resumed
+	if opts.Range != "" || offset > payloadSize {
+		offset = 0
+	}
+	if err = fd.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err = fd.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	if offset < payloadSize || opts.Range != "" {
+		objectOption := opts
+		// the stored payload is downloaded and verified before being decompressed
+		objectOption.DisableDecompression = true
+		if offset > 0 {
+			if err = objectOption.SetRange(offset, payloadSize-1); err != nil {
+				return err
+			}
+			log.Debug().Msgf("resume downloading object %s from offset %d to %s", objectName, offset, tempFilePath)
+		}
+		body, _, err := c.GetObject(ctx, bucketName, objectName, objectOption)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(fd, body)
+		body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Range == "" && payloadSize > 0 && len(objectDetail.ObjectInfo.Checksums) > 0 {
+		params, err := c.GetParams()
+		if err != nil {
+			return err
+		}
+		if err = verifyPrimaryChecksum(fd, int64(params.VersionedParams.GetMaxSegmentSize()), objectDetail.ObjectInfo.Checksums[0]); err != nil {
+			fd.Close()
+			os.Remove(tempFilePath)
+			return err
+		}
+	}
+
+	if err = fd.Sync(); err != nil {
+		return err
+	}
+	_, encoding := types.ParseContentEncoding(objectDetail.ObjectInfo.ContentType)
+	if encoding != types.CompressionNone && opts.Range == "" && !opts.DisableDecompression {
+		if err = decompressFile(fd, filePath, encoding); err != nil {
+			return err
+		}
+		fd.Close()
+		return os.Remove(tempFilePath)
+	}
+	if err = fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFilePath, filePath)
+}
 
+// verifyPrimaryChecksum computes the integrity hash of the segments of the file and compares it with the checksum
+// of the primary SP recorded on chain
+func verifyPrimaryChecksum(file *os.File, segmentSize int64, expectChecksum []byte) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReaderSize(file, types.FileReadBufferSize)
+	segment := make([]byte, segmentSize)
+	var checksums [][]byte
+	for {
+		n, err := io.ReadFull(reader, segment)
+		if n > 0 {
+			checksums = append(checksums, hashlib.GenerateChecksum(segment[:n]))
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(hashlib.GenerateIntegrityHash(checksums), expectChecksum) {
+		return types.ErrorChecksumMismatch
+	}
 	return nil
 }
 
@@ -579,7 +1837,11 @@ func (c *client) FGetObjectResumable(ctx context.Context, bucketName, objectName
 		return err
 	}
 
-	tempFilePath := filePath + "_" + c.defaultAccount.GetAddress().String() + opts.Range + types.TempFileSuffix
+	// the anonymous download of the client without account keeps its progress in the file without address
+	tempFilePath := filePath + "_" + opts.Range + types.TempFileSuffix
+	if account := c.loadDefaultAccount(); account != nil {
+		tempFilePath = filePath + "_" + account.GetAddress().String() + opts.Range + types.TempFileSuffix
+	}
 
 	var (
 		startOffset    int64
@@ -779,6 +2041,51 @@ func (c *client) HeadObject(ctx context.Context, bucketName, objectName string)
 	}, nil
 }
 
+// GetObjectsSealStatus queries the objects with at most SealStatusQueryConcurrency queries in flight, it returns an
+// error only if the bucket name is invalid or ctx is done
+func (c *client) GetObjectsSealStatus(ctx context.Context, bucketName string, objectNames []string) ([]types.ObjectSealStatus, error) {
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]types.ObjectSealStatus, len(objectNames))
+		sem     = make(chan struct{}, types.SealStatusQueryConcurrency)
+	)
+	for i, objectName := range objectNames {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+		wg.Add(1)
+		go func(i int, objectName string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := types.ObjectSealStatus{ObjectName: objectName}
+			objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+			if err != nil {
+				result.Err = err
+			} else {
+				result.Status = objectDetail.ObjectInfo.GetObjectStatus()
+				result.Sealed = result.Status == storageTypes.OBJECT_STATUS_SEALED
+			}
+			// each goroutine writes its own element, so no lock is needed
+			results[i] = result
+		}(i, objectName)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // HeadObjectByID query the objectInfo on chain by object id, return the object info if exists
 // return err info if object not exist
 func (c *client) HeadObjectByID(ctx context.Context, objID string) (*types.ObjectDetail, error) {
@@ -800,28 +2107,53 @@ func (c *client) HeadObjectByID(ctx context.Context, objID string) (*types.Objec
 func (c *client) PutObjectPolicy(ctx context.Context, bucketName, objectName string, principalStr types.Principal,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
 ) (string, error) {
-	resource := gnfdTypes.NewObjectGRN(bucketName, objectName)
+	putPolicyMsg, err := c.BuildPutObjectPolicyMsg(bucketName, objectName, principalStr, statements, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+}
+
+// BuildPutObjectPolicyMsg constructs the putPolicy msg of the object with the default account as the operator
+func (c *client) BuildPutObjectPolicyMsg(bucketName, objectName string, principalStr types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (*storageTypes.MsgPutPolicy, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return nil, err
+	}
+	resource, err := resourceUtils.NewObjectGRN(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
 
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	putPolicyMsg := storageTypes.NewMsgPutPolicy(c.MustGetDefaultAccount().GetAddress(), resource.String(),
+	putPolicyMsg := storageTypes.NewMsgPutPolicy(account.GetAddress(), resource,
 		principal, statements, opt.PolicyExpireTime)
-
-	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+	if err := putPolicyMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return putPolicyMsg, nil
 }
 
 // DeleteObjectPolicy delete the object policy of the principal
 func (c *client) DeleteObjectPolicy(ctx context.Context, bucketName, objectName string, principalStr types.Principal, opt types.DeletePolicyOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
 		return "", err
 	}
 
 	resource := gnfdTypes.NewObjectGRN(bucketName, objectName)
-	return c.sendDelPolicyTxn(ctx, c.MustGetDefaultAccount().GetAddress(), resource.String(), principal, opt.TxOpts)
+	return c.sendDelPolicyTxn(ctx, account.GetAddress(), resource.String(), principal, opt.TxOpts)
 }
 
 // IsObjectPermissionAllowed check if the permission of the object is allowed to the user
@@ -847,6 +2179,243 @@ func (c *client) IsObjectPermissionAllowed(ctx context.Context, userAddr string,
 	return verifyResp.Effect, nil
 }
 
+// GrantObjectAccess puts the policy, then verifies the actions one by one against the state after the tx is committed
+func (c *client) GrantObjectAccess(ctx context.Context, bucketName, objectName string, granteeAddr string,
+	actions []permTypes.ActionType, expiry *time.Time,
+) (string, error) {
+	if len(actions) == 0 {
+		return "", errors.New("no action is specified to grant")
+	}
+	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
+	if err != nil {
+		return "", err
+	}
+	principal, err := utils.NewPrincipalWithAccount(grantee)
+	if err != nil {
+		return "", err
+	}
+	statement := utils.NewStatement(actions, permTypes.EFFECT_ALLOW, nil, types.NewStatementOptions{})
+	txHash, err := c.PutObjectPolicy(ctx, bucketName, objectName, principal,
+		[]*permTypes.Statement{&statement}, types.PutPolicyOption{PolicyExpireTime: expiry})
+	if err != nil {
+		return "", err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	txResult, err := c.WaitForTx(ctxTimeout, txHash)
+	if err != nil {
+		return txHash, err
+	}
+	if txResult.TxResult.Code != 0 {
+		return txHash, fmt.Errorf("the tx %s to put the policy failed, code: %d, log: %s", txHash, txResult.TxResult.Code, txResult.TxResult.Log)
+	}
+	for _, action := range actions {
+		effect, err := c.IsObjectPermissionAllowed(ctx, granteeAddr, bucketName, objectName, action)
+		if err != nil {
+			return txHash, err
+		}
+		if effect != permTypes.EFFECT_ALLOW {
+			return txHash, fmt.Errorf("%w: the effect of %s on object %s is %s", types.ErrorGrantNotEffective, action, objectName, effect)
+		}
+	}
+	return txHash, nil
+}
+
+// IsPermissionsAllowed fans out the permission verifications with at most PermissionCheckConcurrency queries
+// in flight, it returns the first error encountered
+func (c *client) IsPermissionsAllowed(ctx context.Context, userAddr string, resources []types.ResourceAction) (map[types.ResourceAction]permTypes.Effect, error) {
+	if _, err := sdk.AccAddressFromHexUnsafe(userAddr); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		results  = make(map[types.ResourceAction]permTypes.Effect, len(resources))
+		sem      = make(chan struct{}, types.PermissionCheckConcurrency)
+	)
+	seen := make(map[types.ResourceAction]struct{}, len(resources))
+	for _, resource := range resources {
+		if _, ok := seen[resource]; ok {
+			continue
+		}
+		seen[resource] = struct{}{}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(resource types.ResourceAction) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var (
+				effect permTypes.Effect
+				err    error
+			)
+			if resource.ObjectName == "" {
+				effect, err = c.IsBucketPermissionAllowed(ctx, userAddr, resource.BucketName, resource.Action)
+			} else {
+				effect, err = c.IsObjectPermissionAllowed(ctx, userAddr, resource.BucketName, resource.ObjectName, resource.Action)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			results[resource] = effect
+		}(resource)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
+
+var (
+	// the read actions allowed on the public buckets and objects, which are consistent with the storage module
+	publicReadBucketActions = map[permTypes.ActionType]bool{
+		permTypes.ACTION_GET_OBJECT:     true,
+		permTypes.ACTION_COPY_OBJECT:    true,
+		permTypes.ACTION_EXECUTE_OBJECT: true,
+		permTypes.ACTION_LIST_OBJECT:    true,
+	}
+	publicReadObjectActions = map[permTypes.ActionType]bool{
+		permTypes.ACTION_GET_OBJECT:     true,
+		permTypes.ACTION_COPY_OBJECT:    true,
+		permTypes.ACTION_EXECUTE_OBJECT: true,
+	}
+)
+
+// ExplainPermission verifies the permission by chain and derives the rule which produces the effect in the same
+// order as chain does. The group policies can not be listed by the user, so the effect not explained by the
+// visibility, the ownership and the policies of the user is attributed to the group policies.
+func (c *client) ExplainPermission(ctx context.Context, userAddr string, bucketName, objectName string,
+	action permTypes.ActionType,
+) (*types.PermissionExplanation, error) {
+	userAcc, err := sdk.AccAddressFromHexUnsafe(userAddr)
+	if err != nil {
+		return nil, err
+	}
+	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		effect          permTypes.Effect
+		objectInfo      *storageTypes.ObjectInfo
+		publicReadable  bool
+		owner           = bucketInfo.Owner
+		bucketPolicyOpt *permTypes.VerifyOptions
+	)
+	if objectName == "" {
+		effect, err = c.IsBucketPermissionAllowed(ctx, userAddr, bucketName, action)
+		if err != nil {
+			return nil, err
+		}
+		publicReadable = bucketInfo.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ && publicReadBucketActions[action]
+	} else {
+		effect, err = c.IsObjectPermissionAllowed(ctx, userAddr, bucketName, objectName, action)
+		if err != nil {
+			return nil, err
+		}
+		objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+		if err != nil {
+			return nil, err
+		}
+		objectInfo = objectDetail.ObjectInfo
+		owner = objectInfo.Owner
+		visible := objectInfo.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ ||
+			(objectInfo.Visibility == storageTypes.VISIBILITY_TYPE_INHERIT && bucketInfo.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ)
+		publicReadable = visible && publicReadObjectActions[action]
+		bucketPolicyOpt = &permTypes.VerifyOptions{Resource: gnfdTypes.NewObjectGRN(bucketName, objectName).String()}
+	}
+
+	explanation := &types.PermissionExplanation{Effect: effect}
+	switch {
+	case publicReadable:
+		explanation.Rule = types.PermissionRulePublicVisibility
+	case sdk.MustAccAddressFromHex(owner).Equals(userAcc):
+		explanation.Rule = types.PermissionRuleOwner
+	default:
+		rule, ruleEffect, policy, err := c.explainPolicies(ctx, userAddr, bucketName, objectName, action, bucketPolicyOpt)
+		if err != nil {
+			return nil, err
+		}
+		explanation.Rule, explanation.Policy = rule, policy
+		// the group policies are verified when the policies of the user have no explicit effect,
+		// and the denial of the group policies on the bucket overrides the allowance of the object policy
+		if ruleEffect != effect {
+			explanation.Rule, explanation.Policy = types.PermissionRuleGroupPolicy, nil
+		}
+	}
+	return explanation, nil
+}
+
+// explainPolicies evaluates the bucket and object policies of the user, it returns PermissionRuleDefaultDeny if
+// none of the policies has an explicit effect
+func (c *client) explainPolicies(ctx context.Context, userAddr string, bucketName, objectName string,
+	action permTypes.ActionType, bucketPolicyOpt *permTypes.VerifyOptions,
+) (types.PermissionRule, permTypes.Effect, *permTypes.Policy, error) {
+	now := time.Now()
+	bucketPolicy, err := c.GetBucketPolicy(ctx, bucketName, userAddr)
+	if err != nil && !isNoSuchPolicyErr(err) {
+		return "", permTypes.EFFECT_DENY, nil, err
+	}
+	bucketEffect := permTypes.EFFECT_UNSPECIFIED
+	if bucketPolicy != nil {
+		bucketEffect, _ = bucketPolicy.Eval(action, now, bucketPolicyOpt)
+	}
+	if objectName == "" || bucketEffect == permTypes.EFFECT_DENY {
+		if bucketEffect != permTypes.EFFECT_UNSPECIFIED {
+			return types.PermissionRuleBucketPolicy, bucketEffect, bucketPolicy, nil
+		}
+		return types.PermissionRuleDefaultDeny, permTypes.EFFECT_DENY, nil, nil
+	}
+
+	objectPolicy, err := c.GetObjectPolicy(ctx, bucketName, objectName, userAddr)
+	if err != nil && !isNoSuchPolicyErr(err) {
+		return "", permTypes.EFFECT_DENY, nil, err
+	}
+	objectEffect := permTypes.EFFECT_UNSPECIFIED
+	if objectPolicy != nil {
+		objectEffect, _ = objectPolicy.Eval(action, now, nil)
+	}
+	switch {
+	case objectEffect != permTypes.EFFECT_UNSPECIFIED:
+		return types.PermissionRuleObjectPolicy, objectEffect, objectPolicy, nil
+	case bucketEffect == permTypes.EFFECT_ALLOW:
+		return types.PermissionRuleBucketPolicy, bucketEffect, bucketPolicy, nil
+	default:
+		return types.PermissionRuleDefaultDeny, permTypes.EFFECT_DENY, nil, nil
+	}
+}
+
+// isNoSuchPolicyErr returns whether the error of the policy query indicates the policy does not exist
+func isNoSuchPolicyErr(err error) bool {
+	return strings.Contains(err.Error(), storageTypes.ErrNoSuchPolicy.Error())
+}
+
+// isAccessDeniedErr returns true if the SP rejects the request for lacking permission
+func isAccessDeniedErr(err error) bool {
+	var errResp types.ErrResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	return errResp.StatusCode == http.StatusUnauthorized || errResp.StatusCode == http.StatusForbidden
+}
+
 // GetObjectPolicy get the object policy info of the user specified by principalAddr
 func (c *client) GetObjectPolicy(ctx context.Context, bucketName, objectName string, principalAddr string) (*permTypes.Policy, error) {
 	_, err := sdk.AccAddressFromHexUnsafe(principalAddr)
@@ -874,9 +2443,11 @@ func (c *client) ListObjects(ctx context.Context, bucketName string, opts types.
 		return types.ListObjectsResult{}, err
 	}
 
-	const listObjectsDefaultMaxKeys = 1000
+	if err := opts.Validate(); err != nil {
+		return types.ListObjectsResult{}, err
+	}
 	if opts.MaxKeys == 0 {
-		opts.MaxKeys = listObjectsDefaultMaxKeys
+		opts.MaxKeys = types.ListObjectsMaxKeys
 	}
 
 	if opts.StartAfter != "" {
@@ -921,7 +2492,7 @@ func (c *client) ListObjects(ctx context.Context, bucketName string, opts types.
 		disableCloseBody: true,
 	}
 
-	endpoint, err := c.getEndpointByOpt(opts.EndPointOptions)
+	endpoint, err := c.getEndpointByOpt(ctx, opts.EndPointOptions)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
 		return types.ListObjectsResult{}, err
@@ -950,14 +2521,13 @@ func (c *client) ListObjects(ctx context.Context, bucketName string, opts types.
 		return types.ListObjectsResult{}, err
 	}
 
-	if opts.ShowRemovedObject {
-		return listObjectsResult, nil
-	}
-
 	// default only return the object that has not been removed
 	objectMetaList := make([]*types.ObjectMeta, 0)
 	for _, objectInfo := range listObjectsResult.Objects {
-		if objectInfo.Removed {
+		if objectInfo.Removed && !opts.ShowRemovedObject {
+			continue
+		}
+		if !opts.MatchObject(objectInfo.ObjectInfo) {
 			continue
 		}
 
@@ -975,7 +2545,7 @@ func (c *client) GetCreateObjectApproval(ctx context.Context, createObjectMsg *s
 
 	// set the action type
 	urlValues := url.Values{
-		"action": {types.CreateObjectAction},
+		"action": {string(types.CreateObjectAction)},
 	}
 
 	reqMeta := requestMeta{
@@ -988,6 +2558,7 @@ func (c *client) GetCreateObjectApproval(ctx context.Context, createObjectMsg *s
 	sendOpt := sendOptions{
 		method:     http.MethodGet,
 		isAdminApi: true,
+		timeout:    c.approvalTimeout,
 	}
 
 	bucketName := createObjectMsg.BucketName
@@ -1049,6 +2620,55 @@ func (c *client) GetObjectUploadProgress(ctx context.Context, bucketName, object
 	return status.ObjectInfo.ObjectStatus.String(), nil
 }
 
+// ListObjectsIterator return an iterator which lazily lists all the objects of the bucket
+func (c *client) ListObjectsIterator(ctx context.Context, bucketName string, opts types.ListObjectsOptions) *types.Iterator[*types.ObjectMeta] {
+	return types.NewIterator(func() ([]*types.ObjectMeta, bool, error) {
+		result, err := c.ListObjects(ctx, bucketName, opts)
+		if err != nil {
+			return nil, false, err
+		}
+		opts.ContinuationToken = result.NextContinuationToken
+		return result.Objects, result.IsTruncated && result.NextContinuationToken != "", nil
+	})
+}
+
+// ListDeletedObjects lists all the pages of the objects including the removed ones and returns the removed objects
+func (c *client) ListDeletedObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) ([]*types.ObjectMeta, error) {
+	opts.ShowRemovedObject = true
+	iter := c.ListObjectsIterator(ctx, bucketName, opts)
+	defer iter.Close()
+
+	deletedObjects := make([]*types.ObjectMeta, 0)
+	for iter.Next() {
+		if objectMeta := iter.Value(); objectMeta.Removed {
+			deletedObjects = append(deletedObjects, objectMeta)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return deletedObjects, nil
+}
+
+// ListUnsealedObjects lists all the pages of the objects and returns the ones in the created status
+func (c *client) ListUnsealedObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) ([]*types.ObjectMeta, error) {
+	opts.ShowRemovedObject = false
+	iter := c.ListObjectsIterator(ctx, bucketName, opts)
+	defer iter.Close()
+
+	unsealedObjects := make([]*types.ObjectMeta, 0)
+	for iter.Next() {
+		objectMeta := iter.Value()
+		if objectMeta.ObjectInfo != nil && !objectMeta.Removed && objectMeta.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
+			unsealedObjects = append(unsealedObjects, objectMeta)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return unsealedObjects, nil
+}
+
 // GetObjectResumableUploadOffset return the status of object including the uploading progress
 func (c *client) GetObjectResumableUploadOffset(ctx context.Context, bucketName, objectName string) (uint64, error) {
 	status, err := c.HeadObject(ctx, bucketName, objectName)
@@ -1153,6 +2773,10 @@ func (c *client) getObjectStatusFromSP(ctx context.Context, bucketName, objectNa
 func (c *client) UpdateObjectVisibility(ctx context.Context, bucketName, objectName string,
 	visibility storageTypes.VisibilityType, opt types.UpdateObjectOption,
 ) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	object, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return "", fmt.Errorf("object:%s not exists: %s\n", objectName, err.Error())
@@ -1162,7 +2786,7 @@ func (c *client) UpdateObjectVisibility(ctx context.Context, bucketName, objectN
 		return "", fmt.Errorf("the visibility of object:%s is already %s \n", objectName, visibility.String())
 	}
 
-	updateObjectMsg := storageTypes.NewMsgUpdateObjectInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName, visibility)
+	updateObjectMsg := storageTypes.NewMsgUpdateObjectInfo(account.GetAddress(), bucketName, objectName, visibility)
 
 	// set the default txn broadcast mode as sync mode
 	if opt.TxOpts == nil {
@@ -1210,7 +2834,7 @@ func (c *client) ListObjectsByObjectID(ctx context.Context, objectIds []uint64,
 		disableCloseBody: true,
 	}
 
-	endpoint, err := c.getEndpointByOpt(&opts)
+	endpoint, err := c.getEndpointByOpt(ctx, &opts)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("get endpoint by option failed %s", err.Error()))
 		return types.ListObjectsByObjectIDResponse{}, err
@@ -47,8 +47,12 @@ type AuthNonce struct {
 
 // getNonce
 func (c *client) GetNextNonce(spEndpoint string) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	header := make(map[string]string)
-	header["X-Gnfd-User-Address"] = c.defaultAccount.GetAddress().String()
+	header["X-Gnfd-User-Address"] = account.GetAddress().String()
 	header["X-Gnfd-App-Domain"] = c.offChainAuthOption.Domain
 
 	response, err := HttpGetWithHeader(spEndpoint+"/auth/request_nonce", header)
@@ -79,6 +83,10 @@ Resources:
 )
 
 func (c *client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	appDomain := c.offChainAuthOption.Domain
 	eddsaSeed := c.offChainAuthOption.Seed
 	nextNonce, err := c.GetNextNonce(spEndpoint)
@@ -89,14 +97,15 @@ func (c *client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (st
 	userEddsaPublicKeyStr := GetEddsaCompressedPublicKey(eddsaSeed)
 	log.Info().Msg("userEddsaPublicKeyStr is " + userEddsaPublicKeyStr)
 
-	IssueDate := time.Now().Format(time.RFC3339)
+	now := c.now()
+	IssueDate := now.Format(time.RFC3339)
 	// ExpiryDate formate := "2023-06-27T06:35:24Z"
-	ExpiryDate := time.Now().Add(time.Hour * 24).Format(time.RFC3339)
+	ExpiryDate := now.Add(time.Hour * 24).Format(time.RFC3339)
 
-	unSignedContent := fmt.Sprintf(UnsignedContentTemplate, appDomain, c.defaultAccount.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate, spAddress, nextNonce)
+	unSignedContent := fmt.Sprintf(UnsignedContentTemplate, appDomain, account.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate, spAddress, nextNonce)
 
 	unSignedContentHash := accounts.TextHash([]byte(unSignedContent))
-	sig, _ := c.defaultAccount.GetKeyManager().Sign(unSignedContentHash)
+	sig, _ := account.GetKeyManager().Sign(unSignedContentHash)
 	authString := fmt.Sprintf("%s,SignedMsg=%s,Signature=%s", httplib.Gnfd1EthPersonalSign, unSignedContent, hexutil.Encode(sig))
 	authString = strings.ReplaceAll(authString, "\n", "\\n")
 	headers := make(map[string]string)
@@ -106,7 +115,7 @@ func (c *client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (st
 	headers["X-Gnfd-Expiry-Timestamp"] = ExpiryDate
 	headers["authorization"] = authString
 	headers["origin"] = appDomain
-	headers["x-gnfd-user-address"] = c.defaultAccount.GetAddress().String()
+	headers["x-gnfd-user-address"] = account.GetAddress().String()
 	jsonResult, error1 := HttpPostWithHeader(spEndpoint+"/auth/update_key", "{}", headers)
 
 	return jsonResult, error1
@@ -2,12 +2,16 @@ package client
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paymentTypes "github.com/bnb-chain/greenfield/x/payment/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
 )
 
 type Payment interface {
@@ -16,6 +20,17 @@ type Payment interface {
 	Deposit(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error)
 	Withdraw(ctx context.Context, fromAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error)
 	DisableRefund(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error)
+	// SettleStreamRecord settles the flows of the stream record up to the current block and reserves the outflows of
+	// the next reserve time, which also resumes a frozen record if its balance is sufficient
+	SettleStreamRecord(ctx context.Context, streamAddress string, txOption gnfdSdkTypes.TxOption) (string, error)
+	// GetSettlementSummary queries the stream records of the owner and its payment accounts, and marks the records
+	// which are frozen or are settled automatically within the warning window
+	GetSettlementSummary(ctx context.Context, owner string, opts types.SettlementSummaryOptions) (*types.SettlementSummary, error)
+	// GetAccountFreezeStatus queries whether the stream record of the account is frozen and the deposit to resume it
+	GetAccountFreezeStatus(ctx context.Context, paymentAddress string) (*types.AccountFreezeStatus, error)
+	// ResumeFrozenAccount deposits the shortfall of the frozen stream record from the default account, which settles
+	// and resumes the record. It returns types.ErrorAccountNotFrozen if the record is not frozen.
+	ResumeFrozenAccount(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error)
 }
 
 // GetStreamRecord retrieves stream record information for a given stream address.
@@ -33,16 +48,20 @@ func (c *client) GetStreamRecord(ctx context.Context, streamAddress string) (*pa
 
 // Deposit deposits BNB to a stream account.
 func (c *client) Deposit(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(toAddress)
 	if err != nil {
 		return "", err
 	}
 	msgDeposit := &paymentTypes.MsgDeposit{
-		Creator: c.MustGetDefaultAccount().GetAddress().String(),
+		Creator: account.GetAddress().String(),
 		To:      accAddress.String(),
 		Amount:  amount,
 	}
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgDeposit}, &txOption)
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msgDeposit}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -51,16 +70,20 @@ func (c *client) Deposit(ctx context.Context, toAddress string, amount math.Int,
 
 // Withdraw withdraws BNB from a stream account.
 func (c *client) Withdraw(ctx context.Context, fromAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(fromAddress)
 	if err != nil {
 		return "", err
 	}
 	msgWithdraw := &paymentTypes.MsgWithdraw{
-		Creator: c.MustGetDefaultAccount().GetAddress().String(),
+		Creator: account.GetAddress().String(),
 		From:    accAddress.String(),
 		Amount:  amount,
 	}
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgWithdraw}, &txOption)
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msgWithdraw}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -69,17 +92,142 @@ func (c *client) Withdraw(ctx context.Context, fromAddress string, amount math.I
 
 // DisableRefund disables refund for a stream account.
 func (c *client) DisableRefund(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(paymentAddress)
 	if err != nil {
 		return "", err
 	}
 	msgDisableRefund := &paymentTypes.MsgDisableRefund{
-		Owner: c.MustGetDefaultAccount().GetAddress().String(),
+		Owner: account.GetAddress().String(),
 		Addr:  accAddress.String(),
 	}
-	tx, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgDisableRefund}, &txOption)
+	tx, err := c.broadcastTx(ctx, []sdk.Msg{msgDisableRefund}, &txOption)
 	if err != nil {
 		return "", err
 	}
 	return tx.TxResponse.TxHash, nil
 }
+
+// SettleStreamRecord deposits 1 wei to the stream account. The chain has no dedicated message for the settlement,
+// and a stream record is settled whenever its balance is changed.
+func (c *client) SettleStreamRecord(ctx context.Context, streamAddress string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	return c.Deposit(ctx, streamAddress, math.OneInt(), txOption)
+}
+
+// GetSettlementSummary queries the dynamic balance of the owner and each payment account of the owner, the owner is
+// skipped if it has no stream record
+func (c *client) GetSettlementSummary(ctx context.Context, owner string, opts types.SettlementSummaryOptions) (*types.SettlementSummary, error) {
+	ownerAcc, err := sdk.AccAddressFromHexUnsafe(owner)
+	if err != nil {
+		return nil, err
+	}
+	window := opts.WarningWindow
+	if window <= 0 {
+		window = types.DefaultSettlementWarningWindow
+	}
+	accountsResp, err := c.chainClient.PaymentAccountsByOwner(ctx, &paymentTypes.QueryPaymentAccountsByOwnerRequest{Owner: ownerAcc.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &types.SettlementSummary{Owner: ownerAcc.String()}
+	for _, account := range append([]string{ownerAcc.String()}, accountsResp.PaymentAccounts...) {
+		balance, err := c.chainClient.DynamicBalance(ctx, &paymentTypes.QueryDynamicBalanceRequest{Account: account})
+		if err != nil {
+			return nil, err
+		}
+		summary.Timestamp = balance.CurrentTimestamp
+		record := balance.StreamRecord
+		// the stream record of an account which has never been charged or deposited to is empty
+		if record.Account == "" {
+			continue
+		}
+
+		settlement := types.StreamSettlement{
+			Account:         record.Account,
+			Status:          record.Status,
+			NetflowRate:     record.NetflowRate,
+			DynamicBalance:  balance.DynamicBalance,
+			BufferBalance:   record.BufferBalance,
+			BankBalance:     balance.BankBalance,
+			SettleTimestamp: record.SettleTimestamp,
+		}
+		if !record.NetflowRate.IsNegative() {
+			settlement.SettleTimestamp = 0
+		}
+		settlement.AtRisk = record.Status == paymentTypes.STREAM_ACCOUNT_STATUS_FROZEN ||
+			(settlement.SettleTimestamp != 0 && settlement.TimeToSettle(time.Unix(balance.CurrentTimestamp, 0)) <= window)
+		summary.Records = append(summary.Records, settlement)
+	}
+
+	sort.SliceStable(summary.Records, func(i, j int) bool {
+		a, b := summary.Records[i].SettleTimestamp, summary.Records[j].SettleTimestamp
+		if a == 0 || b == 0 {
+			return b == 0 && a != 0
+		}
+		return a < b
+	})
+	return summary, nil
+}
+
+// GetAccountFreezeStatus computes the deposit to resume the stream record in the same way as the payment module, which
+// resumes the record once its static balance covers the outflows, including the frozen ones, of the reserve time
+func (c *client) GetAccountFreezeStatus(ctx context.Context, paymentAddress string) (*types.AccountFreezeStatus, error) {
+	accAddress, err := sdk.AccAddressFromHexUnsafe(paymentAddress)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := c.chainClient.DynamicBalance(ctx, &paymentTypes.QueryDynamicBalanceRequest{Account: accAddress.String()})
+	if err != nil {
+		return nil, err
+	}
+	record := balance.StreamRecord
+	status := &types.AccountFreezeStatus{
+		Account:         accAddress.String(),
+		Frozen:          record.Status == paymentTypes.STREAM_ACCOUNT_STATUS_FROZEN,
+		StaticBalance:   math.ZeroInt(),
+		BankBalance:     balance.BankBalance,
+		ReserveBalance:  math.ZeroInt(),
+		DepositToResume: math.ZeroInt(),
+		OutFlowCount:    record.OutFlowCount,
+	}
+	// the stream record of an account which has never been charged or deposited to is empty
+	if record.Account == "" {
+		return status, nil
+	}
+	status.StaticBalance = record.StaticBalance
+	if !status.Frozen {
+		status.ReserveBalance = record.BufferBalance
+		return status, nil
+	}
+
+	params, err := c.chainClient.PaymentQueryClient.Params(ctx, &paymentTypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	totalRate := record.NetflowRate.Add(record.FrozenNetflowRate)
+	status.ReserveBalance = totalRate.Neg().Mul(math.NewIntFromUint64(params.Params.VersionedParams.ReserveTime))
+	if status.ReserveBalance.GT(record.StaticBalance) {
+		status.DepositToResume = status.ReserveBalance.Sub(record.StaticBalance)
+	}
+	return status, nil
+}
+
+// ResumeFrozenAccount deposits 1 wei if the static balance already covers the reserved balance, since the frozen
+// record is only resumed on a deposit
+func (c *client) ResumeFrozenAccount(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	status, err := c.GetAccountFreezeStatus(ctx, paymentAddress)
+	if err != nil {
+		return "", err
+	}
+	if !status.Frozen {
+		return "", types.ErrorAccountNotFrozen
+	}
+	if !status.DepositToResume.IsPositive() {
+		return c.SettleStreamRecord(ctx, paymentAddress, txOption)
+	}
+	return c.Deposit(ctx, paymentAddress, status.DepositToResume, txOption)
+}
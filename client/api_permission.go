@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// Permission is the interface to query the policies by their ids, which is useful when only the ids of the resources
+// are known, e.g. the resources mirrored to another chain
+type Permission interface {
+	// GetPolicyByID returns the policy of the id, policyID indicates the decimal string of the policy id
+	GetPolicyByID(ctx context.Context, policyID string) (*permTypes.Policy, error)
+	// ListPoliciesByResourceID returns the policies of the resource which are put within the window and still exist.
+	// The chain does not index the policies by the resource, so the blocks in the window are scanned for the put
+	// policy events, at most ActivityScanMaxBlocks blocks are scanned and they must not have been pruned by the node.
+	// resourceID indicates the decimal string of the bucket, object or group id.
+	ListPoliciesByResourceID(ctx context.Context, resourceType resource.ResourceType, resourceID string, window types.ActivityWindow) ([]*permTypes.Policy, error)
+}
+
+// GetPolicyByID queries the policy by its id from the chain
+func (c *client) GetPolicyByID(ctx context.Context, policyID string) (*permTypes.Policy, error) {
+	queryPolicyResp, err := c.chainClient.QueryPolicyById(ctx, &storageTypes.QueryPolicyByIdRequest{PolicyId: policyID})
+	if err != nil {
+		return nil, err
+	}
+	return queryPolicyResp.Policy, nil
+}
+
+// ListPoliciesByResourceID collects the ids of the policies put to the resource in the window in the order they are
+// put, then queries the current policies, the deleted policies are skipped
+func (c *client) ListPoliciesByResourceID(ctx context.Context, resourceType resource.ResourceType, resourceID string,
+	window types.ActivityWindow,
+) ([]*permTypes.Policy, error) {
+	if resourceType == resource.RESOURCE_TYPE_UNSPECIFIED {
+		return nil, errors.New("the resource type should be specified")
+	}
+	fromHeight, toHeight, err := c.resolveActivityWindow(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	if toHeight-fromHeight+1 > types.ActivityScanMaxBlocks {
+		return nil, fmt.Errorf("the window from height %d to %d exceeds the max %d blocks to scan", fromHeight, toHeight, types.ActivityScanMaxBlocks)
+	}
+
+	var policyIDs []string
+	seen := make(map[string]bool)
+	collect := func(abciEvents []abci.Event) error {
+		typedEvents, err := events.ParseEvents(abciEvents)
+		if err != nil {
+			return err
+		}
+		for _, typedEvent := range typedEvents {
+			e, ok := typedEvent.(*permTypes.EventPutPolicy)
+			if !ok || e.ResourceType != resourceType || e.ResourceId.String() != resourceID {
+				continue
+			}
+			if id := e.PolicyId.String(); !seen[id] {
+				seen[id] = true
+				policyIDs = append(policyIDs, id)
+			}
+		}
+		return nil
+	}
+	for height := fromHeight; height <= toHeight; height++ {
+		blockResults, err := c.GetBlockResultByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the block results of height %d: %w", height, err)
+		}
+		for _, txResult := range blockResults.TxsResults {
+			if txResult.Code != 0 {
+				continue
+			}
+			if err = collect(txResult.Events); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	policies := make([]*permTypes.Policy, 0, len(policyIDs))
+	for _, policyID := range policyIDs {
+		policy, err := c.GetPolicyByID(ctx, policyID)
+		if err != nil {
+			if isNoSuchPolicyErr(err) {
+				continue
+			}
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
@@ -20,7 +20,11 @@ type Proposal interface {
 }
 
 func (c *client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmount math.Int, title, summary string, opts types.SubmitProposalOptions) (uint64, string, error) {
-	msgSubmitProposal, err := govTypesV1.NewMsgSubmitProposal(msgs, sdk.NewCoins(sdk.NewCoin(gnfdSdkTypes.Denom, depositAmount)), c.defaultAccount.GetAddress().String(), opts.Metadata, title, summary)
+	account, err := c.signingAccount()
+	if err != nil {
+		return 0, "", err
+	}
+	msgSubmitProposal, err := govTypesV1.NewMsgSubmitProposal(msgs, sdk.NewCoins(sdk.NewCoin(gnfdSdkTypes.Denom, depositAmount)), account.GetAddress().String(), opts.Metadata, title, summary)
 	if err != nil {
 		return 0, "", err
 	}
@@ -28,7 +32,7 @@ func (c *client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmou
 	if err != nil {
 		return 0, "", err
 	}
-	txResp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgSubmitProposal}, &opts.TxOption)
+	txResp, err := c.broadcastTx(ctx, []sdk.Msg{msgSubmitProposal}, &opts.TxOption)
 	if err != nil {
 		return 0, "", err
 	}
@@ -54,8 +58,12 @@ func (c *client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmou
 }
 
 func (c *client) VoteProposal(ctx context.Context, proposalID uint64, voteOption govTypesV1.VoteOption, opts types.VoteProposalOptions) (string, error) {
-	msgVote := govTypesV1.NewMsgVote(c.MustGetDefaultAccount().GetAddress(), proposalID, voteOption, opts.Metadata)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgVote}, &opts.TxOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	msgVote := govTypesV1.NewMsgVote(account.GetAddress(), proposalID, voteOption, opts.Metadata)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msgVote}, &opts.TxOption)
 	if err != nil {
 		return "", err
 	}
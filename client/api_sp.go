@@ -3,7 +3,13 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	math2 "math"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,9 +21,12 @@ import (
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
 	spTypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/rs/zerolog/log"
 )
 
 type SP interface {
@@ -39,6 +48,32 @@ type SP interface {
 	// UpdateSpStatus set an SP status between STATUS_IN_SERVICE and STATUS_IN_MAINTENANCE, duration is requested time an SP wish to stay in maintenance mode
 	// for setting to STATUS_IN_SERVICE, duration is set to 0
 	UpdateSpStatus(ctx context.Context, spAddr string, status spTypes.Status, duration int64, TxOption gnfdSdkTypes.TxOption) (string, error)
+	// PingSP sends a request to the status endpoint of the SP and returns the response time
+	// spAddr indicates the HEX-encoded string of the sp operator address
+	PingSP(ctx context.Context, spAddr string) (time.Duration, error)
+	// GetSPHealth returns the availability and response time of the in-service SPs collected by the background health checker,
+	// the health checker is enabled by setting SPHealthCheckInterval in client Option
+	GetSPHealth() []types.SPHealth
+	// RefreshSPs refreshes the cached SP list from chain, the cached SP list is used to route the requests
+	// to SP and to pick the primary SP of new buckets
+	RefreshSPs(ctx context.Context) error
+	// GetSPByEndpoint returns the SP whose endpoint matches the endpoint, the scheme, the default port and
+	// the trailing slash are ignored in matching, and the DNS aliases of the SP host are matched by the resolved IPs.
+	// It returns ErrorSPNotFound if no SP matches.
+	GetSPByEndpoint(ctx context.Context, endpoint string) (*types.StorageProvider, error)
+	// GetSpAddrFromEndpoint returns the HEX-encoded operator address of the SP matched by the operator address or the endpoint
+	GetSpAddrFromEndpoint(ctx context.Context, endpointOrAddr string) (string, error)
+	// VerifySPSignature verifies the signature of the sign bytes by the on-chain approval address of the SP, it returns
+	// types.ErrorInvalidSPSignature if the signature is not signed by the SP
+	VerifySPSignature(ctx context.Context, spAddr string, signBytes []byte, signature []byte) error
+	// VerifyCreateBucketApproval verifies the approval of the createBucket msg is signed by its primary SP, so the
+	// approvals forged by a misbehaving gateway are detected before broadcasting
+	VerifyCreateBucketApproval(ctx context.Context, msg *storageTypes.MsgCreateBucket) error
+	// VerifyCreateObjectApproval verifies the approval of the createObject msg is signed by the primary SP of the bucket
+	VerifyCreateObjectApproval(ctx context.Context, msg *storageTypes.MsgCreateObject) error
+	// VerifySealObjectSignature verifies the sealObject msg is sent by the seal address of the primary SP of the bucket
+	// and its aggregated signature is signed by the BLS keys of the secondary SPs of the global virtual group
+	VerifySealObjectSignature(ctx context.Context, msg *storageTypes.MsgSealObject) error
 }
 
 func (c *client) GetStoragePrice(ctx context.Context, spAddr string) (*spTypes.SpStoragePrice, error) {
@@ -101,19 +136,35 @@ func (c *client) GetStorageProviderInfo(ctx context.Context, SPAddr sdk.AccAddre
 	return gnfdRep.StorageProvider, nil
 }
 
+// RefreshSPs refreshes the cached SP list from chain
+func (c *client) RefreshSPs(ctx context.Context) error {
+	return c.refreshStorageProviders(ctx)
+}
+
 func (c *client) refreshStorageProviders(ctx context.Context) error {
 	gnfdRep, err := c.chainClient.StorageProviders(ctx, &spTypes.QueryStorageProvidersRequest{Pagination: &query.PageRequest{Limit: math2.MaxUint64}})
 	if err != nil {
 		return err
 	}
+	spMap := make(map[uint32]*types.StorageProvider, len(gnfdRep.Sps))
 	for _, spInfo := range gnfdRep.Sps {
+		endpoint := spInfo.Endpoint
+		if c.spEndpointResolver != nil {
+			resolved, err := c.spEndpointResolver.ResolveSPEndpoint(spInfo.OperatorAddress, spInfo.Endpoint)
+			if err != nil {
+				return fmt.Errorf("fail to resolve the endpoint of sp %s: %w", spInfo.OperatorAddress, err)
+			}
+			if resolved != "" {
+				endpoint = resolved
+			}
+		}
 		var useHttps bool
-		if strings.Contains(spInfo.Endpoint, "https") {
+		if strings.Contains(endpoint, "https") {
 			useHttps = true
 		} else {
 			useHttps = c.secure
 		}
-		urlInfo, urlErr := utils.GetEndpointURL(spInfo.Endpoint, useHttps)
+		urlInfo, urlErr := utils.GetEndpointURL(endpoint, useHttps)
 		if urlErr != nil {
 			return urlErr
 		}
@@ -128,14 +179,192 @@ func (c *client) refreshStorageProviders(ctx context.Context) error {
 			Description:     spInfo.Description,
 			BlsKey:          spInfo.BlsKey,
 		}
-		c.storageProviders[sp.Id] = sp
+		spMap[sp.Id] = sp
 	}
+
+	c.spMutex.Lock()
+	c.storageProviders = spMap
+	c.spMutex.Unlock()
 	return nil
 }
 
+// startSPRefresh refreshes the cached SP list in background until the client is closed, whenever an SP is created,
+// edited or its status is updated if the chain events are subscribed, and periodically if the interval is set
+func (c *client) startSPRefresh(interval time.Duration) {
+	refresh := make(chan struct{}, 1)
+	if c.chainEvents != nil {
+		for _, query := range spEventQueries {
+			// the subscriptions are released by closing the client
+			_, err := c.chainEvents.subscribe(c.closeCtx, query, func([]proto.Message) {
+				select {
+				case refresh <- struct{}{}:
+				default:
+				}
+			})
+			if err != nil {
+				log.Error().Msg(fmt.Sprintf("fail to subscribe the storage provider events, err: %s", err.Error()))
+			}
+		}
+	}
+	go func() {
+		var tick <-chan time.Time
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for {
+			select {
+			case <-c.closeCtx.Done():
+				return
+			case <-tick:
+			case <-refresh:
+			}
+			if err := c.refreshStorageProviders(c.closeCtx); err != nil && c.closeCtx.Err() == nil {
+				log.Error().Msg(fmt.Sprintf("fail to refresh storage providers, err: %s", err.Error()))
+			}
+		}
+	}()
+}
+
+// getStorageProviders returns the cached SPs sorted by id
+func (c *client) getStorageProviders() []*types.StorageProvider {
+	c.spMutex.RLock()
+	defer c.spMutex.RUnlock()
+
+	spList := make([]*types.StorageProvider, 0, len(c.storageProviders))
+	for _, sp := range c.storageProviders {
+		spList = append(spList, sp)
+	}
+	sort.Slice(spList, func(i, j int) bool {
+		return spList[i].Id < spList[j].Id
+	})
+	return spList
+}
+
+// getInServiceStorageProviders returns the cached SPs with STATUS_IN_SERVICE status
+func (c *client) getInServiceStorageProviders() []*types.StorageProvider {
+	spList := make([]*types.StorageProvider, 0)
+	for _, sp := range c.getStorageProviders() {
+		if sp.Status == spTypes.STATUS_IN_SERVICE {
+			spList = append(spList, sp)
+		}
+	}
+	return spList
+}
+
+// findStorageProvider returns the first cached SP which matches, the cached SP list is refreshed from chain once
+// if no SP matches since the SP may be created or updated after the last refresh
+func (c *client) findStorageProvider(ctx context.Context, match func(sp *types.StorageProvider) bool) (*types.StorageProvider, error) {
+	for _, sp := range c.getStorageProviders() {
+		if match(sp) {
+			return sp, nil
+		}
+	}
+	// refresh the meta from blockchain
+	if err := c.refreshStorageProviders(ctx); err != nil {
+		return nil, err
+	}
+	for _, sp := range c.getStorageProviders() {
+		if match(sp) {
+			return sp, nil
+		}
+	}
+	return nil, types.ErrorSPNotFound
+}
+
+// GetSPByEndpoint returns the cached SP whose endpoint matches, the SP hosts are resolved to match the DNS aliases
+// if no SP endpoint matches literally
+func (c *client) GetSPByEndpoint(ctx context.Context, endpoint string) (*types.StorageProvider, error) {
+	host, port, err := utils.SplitEndpointHost(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	matchPort := func(sp *types.StorageProvider) bool {
+		spPort := sp.EndPoint.Port()
+		if spPort == "" {
+			spPort = defaultPort(sp.EndPoint.Scheme)
+		}
+		return port == "" || port == spPort
+	}
+
+	sp, err := c.findStorageProvider(ctx, func(sp *types.StorageProvider) bool {
+		return strings.ToLower(sp.EndPoint.Hostname()) == host && matchPort(sp)
+	})
+	if err == nil {
+		return sp, nil
+	}
+	if !errors.Is(err, types.ErrorSPNotFound) {
+		return nil, err
+	}
+
+	// match the DNS aliases by the resolved addresses
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("%w: endpoint %s", types.ErrorSPNotFound, endpoint)
+	}
+	addrSet := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		addrSet[addr] = struct{}{}
+	}
+	for _, sp := range c.getStorageProviders() {
+		if !matchPort(sp) {
+			continue
+		}
+		spAddrs, err := net.DefaultResolver.LookupHost(ctx, sp.EndPoint.Hostname())
+		if err != nil {
+			continue
+		}
+		for _, spAddr := range spAddrs {
+			if _, ok := addrSet[spAddr]; ok {
+				return sp, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: endpoint %s", types.ErrorSPNotFound, endpoint)
+}
+
+// GetSpAddrFromEndpoint returns the operator address of the SP, endpointOrAddr is treated as the operator address
+// if it is a valid HEX-encoded address, otherwise as the endpoint of the SP
+func (c *client) GetSpAddrFromEndpoint(ctx context.Context, endpointOrAddr string) (string, error) {
+	if acc, err := sdk.AccAddressFromHexUnsafe(endpointOrAddr); err == nil {
+		sp, err := c.findStorageProvider(ctx, func(sp *types.StorageProvider) bool {
+			return sp.OperatorAddress.Equals(acc)
+		})
+		if err != nil {
+			return "", fmt.Errorf("%w: operator address %s", err, endpointOrAddr)
+		}
+		return sp.OperatorAddress.String(), nil
+	}
+
+	sp, err := c.GetSPByEndpoint(ctx, endpointOrAddr)
+	if err != nil {
+		return "", err
+	}
+	return sp.OperatorAddress.String(), nil
+}
+
+// defaultPort returns the default port of the url scheme
+func defaultPort(scheme string) string {
+	if strings.ToLower(scheme) == "https" {
+		return "443"
+	}
+	return "80"
+}
+
+// getStorageProviderByID returns the cached SP of the id
+func (c *client) getStorageProviderByID(ctx context.Context, id uint32) (*types.StorageProvider, error) {
+	return c.findStorageProvider(ctx, func(sp *types.StorageProvider) bool {
+		return sp.Id == id
+	})
+}
+
 // CreateStorageProvider will submit a CreateStorageProvider proposal and return proposalID, TxHash and err if it has.
 func (c *client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAddr, approvalAddr, gcAddr, maintenanceAddr, blsPubKey, blsProof, endpoint string, depositAmount math.Int, description spTypes.Description, opts types.CreateStorageProviderOptions) (uint64, string, error) {
-	defaultAccount := c.MustGetDefaultAccount()
+	defaultAccount, err := c.signingAccount()
+	if err != nil {
+		return 0, "", err
+	}
 	govModuleAddress, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return 0, "", err
@@ -203,7 +432,10 @@ func (c *client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAdd
 }
 
 func (c *client) GrantDepositForStorageProvider(ctx context.Context, spAddr string, depositAmount math.Int, opts types.GrantDepositForStorageProviderOptions) (string, error) {
-	granter := c.MustGetDefaultAccount()
+	granter, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	govModuleAddress, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return "", err
@@ -216,14 +448,14 @@ func (c *client) GrantDepositForStorageProvider(ctx context.Context, spAddr stri
 	authorization := spTypes.NewDepositAuthorization(spAcc, &coin)
 
 	if opts.Expiration == nil {
-		expiration := time.Now().Add(24 * time.Hour)
+		expiration := c.now().Add(24 * time.Hour)
 		opts.Expiration = &expiration
 	}
 	msgGrant, err := authz.NewMsgGrant(granter.GetAddress(), govModuleAddress.GetAddress(), authorization, opts.Expiration)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgGrant}, &opts.TxOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msgGrant}, &opts.TxOption)
 	if err != nil {
 		return "", err
 	}
@@ -241,7 +473,7 @@ func (c *client) UpdateSpStoragePrice(ctx context.Context, spAddr string, readPr
 		StorePrice:    storePrice,
 		FreeReadQuota: freeReadQuota,
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgUpdateStoragePrice}, &TxOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msgUpdateStoragePrice}, &TxOption)
 	if err != nil {
 		return "", err
 	}
@@ -258,9 +490,138 @@ func (c *client) UpdateSpStatus(ctx context.Context, spAddr string, status spTyp
 		Status:    status,
 		Duration:  duration,
 	}
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdk.Msg{msgUpdateSpStatus}, &TxOption)
+	resp, err := c.broadcastTx(ctx, []sdk.Msg{msgUpdateSpStatus}, &TxOption)
 	if err != nil {
 		return "", err
 	}
 	return resp.TxResponse.TxHash, nil
 }
+
+// PingSP sends a request to the status endpoint of the SP and returns the response time
+func (c *client) PingSP(ctx context.Context, spAddr string) (time.Duration, error) {
+	endpoint, err := c.getSPUrlByAddr(ctx, spAddr)
+	if err != nil {
+		return 0, err
+	}
+	return c.pingSPEndpoint(ctx, endpoint)
+}
+
+func (c *client) pingSPEndpoint(ctx context.Context, endpoint *url.URL) (time.Duration, error) {
+	reqMeta := requestMeta{
+		urlRelPath:    types.SPStatusUrl,
+		contentSHA256: types.EmptyStringSHA256,
+	}
+
+	sendOpt := sendOptions{
+		method: http.MethodGet,
+	}
+
+	startTime := time.Now()
+	_, err := c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(startTime), nil
+}
+
+// GetSPHealth returns the availability and response time of the in-service SPs collected by the background health checker
+func (c *client) GetSPHealth() []types.SPHealth {
+	c.spHealthMutex.RLock()
+	defer c.spHealthMutex.RUnlock()
+
+	healthList := make([]types.SPHealth, 0, len(c.spHealth))
+	for _, health := range c.spHealth {
+		healthList = append(healthList, *health)
+	}
+	sort.Slice(healthList, func(i, j int) bool {
+		return healthList[i].Id < healthList[j].Id
+	})
+	return healthList
+}
+
+// startSPHealthCheck probes the in-service SPs periodically in background until the client is closed
+func (c *client) startSPHealthCheck(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			c.checkSPHealth(c.closeCtx)
+			select {
+			case <-c.closeCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// checkSPHealth probes all the in-service SPs and records the availability and response time of them
+func (c *client) checkSPHealth(ctx context.Context) {
+	spList, err := c.ListStorageProviders(ctx, true)
+	if err != nil {
+		log.Error().Msg(fmt.Sprintf("fail to list storage providers for health check, err: %s", err.Error()))
+		return
+	}
+
+	healthMap := make(map[uint32]*types.SPHealth, len(spList))
+	for _, sp := range spList {
+		health := &types.SPHealth{
+			Id:            sp.Id,
+			Endpoint:      sp.Endpoint,
+			LastCheckTime: time.Now(),
+		}
+
+		var useHttps bool
+		if strings.Contains(sp.Endpoint, "https") {
+			useHttps = true
+		} else {
+			useHttps = c.secure
+		}
+		endpoint, err := utils.GetEndpointURL(sp.Endpoint, useHttps)
+		if err != nil {
+			health.LastError = err.Error()
+			healthMap[sp.Id] = health
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, types.SPHealthCheckTimeout)
+		latency, err := c.pingSPEndpoint(pingCtx, endpoint)
+		cancel()
+		if err != nil {
+			health.LastError = err.Error()
+		} else {
+			health.Available = true
+			health.Latency = latency
+		}
+		healthMap[sp.Id] = health
+	}
+
+	c.spHealthMutex.Lock()
+	c.spHealth = healthMap
+	c.spHealthMutex.Unlock()
+}
+
+// selectHealthySP returns the available SP with the lowest response time in the SP list,
+// it returns the first SP of the list if no health info has been collected
+func (c *client) selectHealthySP(spList []*types.StorageProvider) *types.StorageProvider {
+	c.spHealthMutex.RLock()
+	defer c.spHealthMutex.RUnlock()
+
+	selected := -1
+	var minLatency time.Duration
+	for idx, sp := range spList {
+		health, ok := c.spHealth[sp.Id]
+		if !ok || !health.Available {
+			continue
+		}
+		if selected == -1 || health.Latency < minLatency {
+			selected = idx
+			minLatency = health.Latency
+		}
+	}
+
+	if selected == -1 {
+		return spList[0]
+	}
+	return spList[selected]
+}
@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+type State interface {
+	// ExportAccountState enumerates the buckets, the objects (metadata only), the groups, the policies and the payment
+	// accounts of the default account into a snapshot, which can be serialized as a JSON manifest. The members of
+	// the groups are not exported as they can not be listed.
+	ExportAccountState(ctx context.Context, opts types.ExportStateOptions) (*types.AccountState, error)
+	// ImportPolicies re-applies the bucket and object policies of the snapshot to the buckets and objects of the same
+	// names owned by the default account, e.g. on another network. The group principals are resolved by the group names
+	// under the default account.
+	ImportPolicies(ctx context.Context, state *types.AccountState, opts types.ImportPoliciesOptions) (*types.ImportPoliciesResult, error)
+	// PlanAccountState compares the desired state, e.g. a manifest parsed by types.ParseAccountState, with the chain and
+	// returns the changes of the buckets, the groups and the policies to reach it without sending any transaction.
+	// The objects are not created or deleted, only the policies listed for the existing objects are planned.
+	PlanAccountState(ctx context.Context, desired *types.AccountState, opts types.PlanOptions) (*types.ApplyPlan, error)
+	// ApplyAccountState sends the transactions of the changes of the plan made by PlanAccountState
+	ApplyAccountState(ctx context.Context, plan *types.ApplyPlan, opts types.ApplyOptions) (*types.ApplyResult, error)
+}
+
+// statePrincipal is a principal whose policies are exported, groupID is zero for the accounts
+type statePrincipal struct {
+	account   string
+	groupName string
+	groupID   uint64
+}
+
+// ExportAccountState lists the resources from the SP metadata service and queries the policies of the principals from chain
+func (c *client) ExportAccountState(ctx context.Context, opts types.ExportStateOptions) (*types.AccountState, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	owner := account.GetAddress().String()
+	for _, principalAddr := range opts.PolicyPrincipals {
+		if _, err = sdk.AccAddressFromHexUnsafe(principalAddr); err != nil {
+			return nil, fmt.Errorf("invalid policy principal %s: %w", principalAddr, err)
+		}
+	}
+
+	height, err := c.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state := &types.AccountState{
+		Owner:      owner,
+		ExportTime: c.now().UTC(),
+		Height:     height,
+	}
+
+	principals := make([]statePrincipal, 0, len(opts.PolicyPrincipals))
+	for _, principalAddr := range opts.PolicyPrincipals {
+		principals = append(principals, statePrincipal{account: principalAddr})
+	}
+	groupIter := c.ListGroupsByOwnerIterator(ctx, types.ListGroupsByOwnerOptions{Owner: owner, EndPointOptions: opts.EndPointOptions})
+	defer groupIter.Close()
+	for groupIter.Next() {
+		group := groupIter.Value()
+		if group.Removed || group.Group == nil {
+			continue
+		}
+		state.Groups = append(state.Groups, &types.GroupState{
+			GroupName: group.Group.GroupName,
+			GroupID:   group.Group.Id.String(),
+			Extra:     group.Group.Extra,
+		})
+		principals = append(principals, statePrincipal{groupName: group.Group.GroupName, groupID: group.Group.Id.Uint64()})
+	}
+	if err = groupIter.Err(); err != nil {
+		return nil, err
+	}
+
+	buckets, err := c.ListBuckets(ctx, types.ListBucketsOptions{EndPointOptions: opts.EndPointOptions})
+	if err != nil {
+		return nil, err
+	}
+	spAddresses := make(map[uint32]string)
+	for _, sp := range c.getStorageProviders() {
+		spAddresses[sp.Id] = sp.OperatorAddress.String()
+	}
+	for _, bucket := range buckets.Buckets {
+		if bucket.Removed || bucket.BucketInfo == nil {
+			continue
+		}
+		bucketInfo := bucket.BucketInfo
+		chargedReadQuota := bucketInfo.ChargedReadQuota
+		bucketState := &types.BucketState{
+			BucketName:       bucketInfo.BucketName,
+			BucketID:         bucketInfo.Id.String(),
+			Visibility:       bucketInfo.Visibility.String(),
+			PrimarySPAddress: spAddresses[bucketInfo.PrimarySpId],
+			PaymentAddress:   bucketInfo.PaymentAddress,
+			ChargedReadQuota: &chargedReadQuota,
+		}
+		if bucketState.Policies, err = c.exportPolicies(ctx, bucketInfo.BucketName, "", principals); err != nil {
+			return nil, err
+		}
+		if !opts.ExcludeObjects {
+			if bucketState.Objects, err = c.exportObjects(ctx, bucketInfo.BucketName, principals, opts); err != nil {
+				return nil, err
+			}
+		}
+		state.Buckets = append(state.Buckets, bucketState)
+	}
+
+	paymentAccounts, err := c.GetPaymentAccountsByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, paymentAccount := range paymentAccounts {
+		state.PaymentAccounts = append(state.PaymentAccounts, &types.PaymentAccountState{
+			Address:    paymentAccount.Addr,
+			Refundable: paymentAccount.Refundable,
+		})
+	}
+	return state, nil
+}
+
+// exportObjects lists the objects of the bucket and the object policies if IncludeObjectPolicies is set
+func (c *client) exportObjects(ctx context.Context, bucketName string, principals []statePrincipal, opts types.ExportStateOptions) ([]*types.ObjectState, error) {
+	iter := c.ListObjectsIterator(ctx, bucketName, types.ListObjectsOptions{EndPointOptions: opts.EndPointOptions})
+	defer iter.Close()
+
+	var objects []*types.ObjectState
+	for iter.Next() {
+		objectInfo := iter.Value().ObjectInfo
+		if objectInfo == nil {
+			continue
+		}
+		checksums := make([]string, 0, len(objectInfo.Checksums))
+		for _, checksum := range objectInfo.Checksums {
+			checksums = append(checksums, hex.EncodeToString(checksum))
+		}
+		objectState := &types.ObjectState{
+			ObjectName:     objectInfo.ObjectName,
+			ObjectID:       objectInfo.Id.String(),
+			PayloadSize:    objectInfo.PayloadSize,
+			ContentType:    objectInfo.ContentType,
+			Visibility:     objectInfo.Visibility.String(),
+			Status:         objectInfo.ObjectStatus.String(),
+			RedundancyType: objectInfo.RedundancyType.String(),
+			Checksums:      checksums,
+		}
+		if opts.IncludeObjectPolicies {
+			policies, err := c.exportPolicies(ctx, bucketName, objectInfo.ObjectName, principals)
+			if err != nil {
+				return nil, err
+			}
+			objectState.Policies = policies
+		}
+		objects = append(objects, objectState)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// exportPolicies queries the policies of the principals on the bucket, or on the object if objectName is not empty
+func (c *client) exportPolicies(ctx context.Context, bucketName, objectName string, principals []statePrincipal) ([]*types.PolicyState, error) {
+	var policies []*types.PolicyState
+	for _, principal := range principals {
+		var (
+			policy *permTypes.Policy
+			err    error
+		)
+		switch {
+		case principal.groupID != 0 && objectName == "":
+			policy, err = c.GetBucketPolicyOfGroup(ctx, bucketName, principal.groupID)
+		case principal.groupID != 0:
+			policy, err = c.GetObjectPolicyOfGroup(ctx, bucketName, objectName, principal.groupID)
+		case objectName == "":
+			policy, err = c.GetBucketPolicy(ctx, bucketName, principal.account)
+		default:
+			policy, err = c.GetObjectPolicy(ctx, bucketName, objectName, principal.account)
+		}
+		if err != nil {
+			if isNoSuchPolicyErr(err) {
+				continue
+			}
+			return nil, err
+		}
+		if policy == nil {
+			continue
+		}
+		policies = append(policies, &types.PolicyState{
+			PrincipalAccount: principal.account,
+			PrincipalGroup:   principal.groupName,
+			Statements:       policy.Statements,
+			ExpirationTime:   policy.ExpirationTime,
+		})
+	}
+	return policies, nil
+}
+
+// ImportPolicies builds the putPolicy msgs of the snapshot and broadcasts them in batches
+func (c *client) ImportPolicies(ctx context.Context, state *types.AccountState, opts types.ImportPoliciesOptions) (*types.ImportPoliciesResult, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	owner := account.GetAddress().String()
+	if state == nil {
+		return nil, errors.New("the account state is empty")
+	}
+
+	result := &types.ImportPoliciesResult{}
+	groupPrincipals := make(map[string]types.Principal)
+	var msgs []sdk.Msg
+	addPolicies := func(bucketName, objectName string, policies []*types.PolicyState) error {
+		for _, policyState := range policies {
+			resourceName := bucketName
+			if objectName != "" {
+				resourceName = bucketName + "/" + objectName
+			}
+			var principal types.Principal
+			if policyState.PrincipalGroup != "" {
+				var ok bool
+				if principal, ok = groupPrincipals[policyState.PrincipalGroup]; !ok {
+					principal, err = c.newGroupPrincipal(ctx, owner, policyState.PrincipalGroup)
+					if err != nil {
+						if opts.SkipMissing && isNotFoundErr(err) {
+							result.Skipped = append(result.Skipped, fmt.Sprintf("the policy of group %s on %s: the group does not exist", policyState.PrincipalGroup, resourceName))
+							continue
+						}
+						return fmt.Errorf("failed to resolve the group %s: %w", policyState.PrincipalGroup, err)
+					}
+					groupPrincipals[policyState.PrincipalGroup] = principal
+				}
+			} else {
+				principalAddr, err := sdk.AccAddressFromHexUnsafe(policyState.PrincipalAccount)
+				if err != nil {
+					return fmt.Errorf("invalid principal of the policy on %s: %w", resourceName, err)
+				}
+				if principal, err = utils.NewPrincipalWithAccount(principalAddr); err != nil {
+					return err
+				}
+			}
+
+			putOpt := types.PutPolicyOption{PolicyExpireTime: policyState.ExpirationTime}
+			var msg *storageTypes.MsgPutPolicy
+			if objectName == "" {
+				msg, err = c.BuildPutBucketPolicyMsg(bucketName, principal, policyState.Statements, putOpt)
+			} else {
+				msg, err = c.BuildPutObjectPolicyMsg(bucketName, objectName, principal, policyState.Statements, putOpt)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid policy on %s: %w", resourceName, err)
+			}
+			msgs = append(msgs, msg)
+		}
+		return nil
+	}
+
+	for _, bucketState := range state.Buckets {
+		if _, err = c.HeadBucket(ctx, bucketState.BucketName); err != nil {
+			if opts.SkipMissing && isNotFoundErr(err) {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("the policies of bucket %s: the bucket does not exist", bucketState.BucketName))
+				continue
+			}
+			return nil, err
+		}
+		if err = addPolicies(bucketState.BucketName, "", bucketState.Policies); err != nil {
+			return nil, err
+		}
+		for _, objectState := range bucketState.Objects {
+			if len(objectState.Policies) == 0 {
+				continue
+			}
+			if _, err = c.HeadObject(ctx, bucketState.BucketName, objectState.ObjectName); err != nil {
+				if opts.SkipMissing && isNotFoundErr(err) {
+					result.Skipped = append(result.Skipped, fmt.Sprintf("the policies of object %s/%s: the object does not exist",
+						bucketState.BucketName, objectState.ObjectName))
+					continue
+				}
+				return nil, err
+			}
+			if err = addPolicies(bucketState.BucketName, objectState.ObjectName, objectState.Policies); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = types.DefaultImportPoliciesBatchSize
+	}
+	for start := 0; start < len(msgs); start += batchSize {
+		end := start + batchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		txnHash, err := c.broadcastAndWait(ctx, msgs[start:end], opts.TxOpts)
+		if err != nil {
+			return result, err
+		}
+		result.Applied += end - start
+		result.TxHashes = append(result.TxHashes, txnHash)
+	}
+	return result, nil
+}
+
+// broadcastAndWait broadcasts the msgs in one transaction and waits for it to be committed, so that the nonce of the
+// next transaction is correct
+func (c *client) broadcastAndWait(ctx context.Context, msgs []sdk.Msg, txOpts *gnfdSdkTypes.TxOption) (string, error) {
+	resp, err := c.broadcastTx(ctx, msgs, txOpts)
+	if err != nil {
+		return "", err
+	}
+	txnHash := resp.TxResponse.TxHash
+	ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	txnResponse, err := c.WaitForTx(ctxTimeout, txnHash)
+	if err != nil {
+		return txnHash, fmt.Errorf("the transaction %s has been submitted, please check it later: %w", txnHash, err)
+	}
+	if txnResponse.TxResult.Code != 0 {
+		return txnHash, fmt.Errorf("the transaction %s has failed with response code: %d, log: %s", txnHash, txnResponse.TxResult.Code, txnResponse.TxResult.Log)
+	}
+	return txnHash, nil
+}
+
+// isNotFoundErr returns true if the chain reports the bucket, object or group does not exist
+func isNotFoundErr(err error) bool {
+	for _, notFoundErr := range []error{storageTypes.ErrNoSuchBucket, storageTypes.ErrNoSuchObject, storageTypes.ErrNoSuchGroup} {
+		if strings.Contains(err.Error(), notFoundErr.Error()) {
+			return true
+		}
+	}
+	return false
+}
@@ -89,6 +89,10 @@ func (c *client) CreateValidator(ctx context.Context, description stakingtypes.D
 func (c *client) EditValidator(ctx context.Context, description stakingtypes.Description,
 	newRate *sdktypes.Dec, newMinSelfDelegation *math.Int, newRelayerAddr, newChallengerAddr, newBlsKey, blsProof string, txOption gnfdsdktypes.TxOption,
 ) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	relayer, err := sdktypes.AccAddressFromHexUnsafe(newRelayerAddr)
 	if err != nil {
 		return "", err
@@ -97,8 +101,8 @@ func (c *client) EditValidator(ctx context.Context, description stakingtypes.Des
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgEditValidator(c.MustGetDefaultAccount().GetAddress(), description, newRate, newMinSelfDelegation, relayer, challenger, newBlsKey, blsProof)
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := stakingtypes.NewMsgEditValidator(account.GetAddress(), description, newRate, newMinSelfDelegation, relayer, challenger, newBlsKey, blsProof)
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -107,12 +111,16 @@ func (c *client) EditValidator(ctx context.Context, description stakingtypes.Des
 
 // DelegateValidator makes a delegation to a validator by delegator.
 func (c *client) DelegateValidator(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgDelegate(c.MustGetDefaultAccount().GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := stakingtypes.NewMsgDelegate(account.GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -121,6 +129,10 @@ func (c *client) DelegateValidator(ctx context.Context, validatorAddr string, am
 
 // BeginRedelegate delegates coins from a delegator and source validator to a destination validator
 func (c *client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validatorDestAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validatorSrc, err := sdktypes.AccAddressFromHexUnsafe(validatorSrcAddr)
 	if err != nil {
 		return "", err
@@ -129,8 +141,8 @@ func (c *client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validato
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgBeginRedelegate(c.MustGetDefaultAccount().GetAddress(), validatorSrc, validatorDest, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := stakingtypes.NewMsgBeginRedelegate(account.GetAddress(), validatorSrc, validatorDest, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -139,12 +151,16 @@ func (c *client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validato
 
 // Undelegate undelegates tokens from a validator by the delegator.
 func (c *client) Undelegate(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgUndelegate(c.MustGetDefaultAccount().GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := stakingtypes.NewMsgUndelegate(account.GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -153,12 +169,16 @@ func (c *client) Undelegate(ctx context.Context, validatorAddr string, amount ma
 
 // CancelUnbondingDelegation cancel the unbonding delegation by delegator
 func (c *client) CancelUnbondingDelegation(ctx context.Context, validatorAddr string, creationHeight int64, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgCancelUnbondingDelegation(c.MustGetDefaultAccount().GetAddress(), validator, creationHeight, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(account.GetAddress(), validator, creationHeight, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -167,26 +187,30 @@ func (c *client) CancelUnbondingDelegation(ctx context.Context, validatorAddr st
 
 // GrantDelegationForValidator grant the gov module for proposal execution
 func (c *client) GrantDelegationForValidator(ctx context.Context, delegationAmount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	govModule, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return "", err
 	}
 	delegationCoin := sdktypes.NewCoin(gnfdsdktypes.Denom, delegationAmount)
-	authorization, err := stakingtypes.NewStakeAuthorization([]sdktypes.AccAddress{c.MustGetDefaultAccount().GetAddress()},
+	authorization, err := stakingtypes.NewStakeAuthorization([]sdktypes.AccAddress{account.GetAddress()},
 		nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 		&delegationCoin)
 	if err != nil {
 		return "", err
 	}
 
-	msgGrant, err := authz.NewMsgGrant(c.MustGetDefaultAccount().GetAddress(),
+	msgGrant, err := authz.NewMsgGrant(account.GetAddress(),
 		govModule.GetAddress(),
 		authorization, nil)
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msgGrant}, &txOption)
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msgGrant}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -195,8 +219,12 @@ func (c *client) GrantDelegationForValidator(ctx context.Context, delegationAmou
 
 // UnJailValidator unjails the validator
 func (c *client) UnJailValidator(ctx context.Context, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := slashingtypes.NewMsgUnjail(c.MustGetDefaultAccount().GetAddress())
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
+	msg := slashingtypes.NewMsgUnjail(account.GetAddress())
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
@@ -205,12 +233,16 @@ func (c *client) UnJailValidator(ctx context.Context, txOption gnfdsdktypes.TxOp
 
 // ImpeachValidator impeaches a validator
 func (c *client) ImpeachValidator(ctx context.Context, validatorAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+	account, err := c.signingAccount()
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := slashingtypes.NewMsgImpeach(validator, c.MustGetDefaultAccount().GetAddress())
-	resp, err := c.chainClient.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
+	msg := slashingtypes.NewMsgImpeach(validator, account.GetAddress())
+	resp, err := c.broadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
 	}
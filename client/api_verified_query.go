@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// VerifiedQuery queries the chain state with the merkle proofs, which are verified against the app hashes of the
+// headers verified by the light client, so a malicious or faulty node can not forge the state. It requires
+// Option.LightClient to be set.
+type VerifiedQuery interface {
+	// GetVerifiedStoreValue returns the value of the key in the store of the module and the height of the state. The
+	// value is nil if its absence is proven. The state of the height pinned by types.WithQueryHeight is queried, or
+	// the state of the latest height whose app hash is committed otherwise.
+	GetVerifiedStoreValue(ctx context.Context, storeName string, key []byte) ([]byte, int64, error)
+	// HeadBucketVerified is the verified HeadBucket, it returns types.ErrorStateAbsent if the bucket does not exist
+	HeadBucketVerified(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error)
+	// HeadObjectVerified is the verified HeadObject, it returns types.ErrorStateAbsent if the object does not exist
+	HeadObjectVerified(ctx context.Context, bucketName, objectName string) (*storageTypes.ObjectInfo, error)
+	// GetAccountPolicyVerified returns the verified policy of the resource granted to the account, it returns
+	// types.ErrorStateAbsent if no policy is granted
+	GetAccountPolicyVerified(ctx context.Context, resourceType resource.ResourceType, resourceID math.Uint, principalAddr string) (*permTypes.Policy, error)
+}
+
+// GetVerifiedStoreValue queries the value of the key with the proof and verifies it against the app hash of the
+// height, which is committed in the header of the next height
+func (c *client) GetVerifiedStoreValue(ctx context.Context, storeName string, key []byte) ([]byte, int64, error) {
+	if c.lightClient == nil {
+		return nil, 0, errors.New("the verified queries require the light client of Option.LightClient")
+	}
+	height := types.QueryHeightFromContext(ctx)
+	if height == 0 {
+		status, err := c.proofClient.Status(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+	res, err := c.proofClient.ABCIQueryWithOptions(ctx, "/store/"+storeName+"/key", key,
+		rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, 0, err
+	}
+	resp := res.Response
+	if resp.IsErr() {
+		return nil, 0, fmt.Errorf("fail to query the store %s, code %d: %s", storeName, resp.Code, resp.Log)
+	}
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return nil, 0, fmt.Errorf("no proof of the store %s is returned at height %d", storeName, resp.Height)
+	}
+	lightBlock, err := c.lightClient.VerifyLightBlockAtHeight(ctx, resp.Height+1, time.Now())
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail to verify the header of height %d: %w", resp.Height+1, err)
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).String()
+	proofRuntime := rootmulti.DefaultProofRuntime()
+	if len(resp.Value) == 0 {
+		if err = proofRuntime.VerifyAbsence(resp.ProofOps, lightBlock.AppHash, keyPath); err != nil {
+			return nil, 0, fmt.Errorf("fail to verify the absence proof of the store %s at height %d: %w", storeName, resp.Height, err)
+		}
+		return nil, resp.Height, nil
+	}
+	if err = proofRuntime.VerifyValue(resp.ProofOps, lightBlock.AppHash, keyPath, resp.Value); err != nil {
+		return nil, 0, fmt.Errorf("fail to verify the proof of the store %s at height %d: %w", storeName, resp.Height, err)
+	}
+	return resp.Value, resp.Height, nil
+}
+
+// getVerifiedByID looks up the id stored by the index key, and then the value stored by the key of the id at the same
+// height, which is unmarshalled into v
+func (c *client) getVerifiedByID(ctx context.Context, storeName string, indexKey []byte, idKey func(id math.Uint) []byte,
+	v interface{ Unmarshal([]byte) error }, name string,
+) error {
+	bz, height, err := c.GetVerifiedStoreValue(ctx, storeName, indexKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("%w: %s", types.ErrorStateAbsent, name)
+	}
+	bz, _, err = c.GetVerifiedStoreValue(types.WithQueryHeight(ctx, height), storeName, idKey(math.ZeroUint().SetBytes(bz)))
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("%w: %s", types.ErrorStateAbsent, name)
+	}
+	return v.Unmarshal(bz)
+}
+
+// HeadBucketVerified queries the bucket info by the bucket id indexed by the name
+func (c *client) HeadBucketVerified(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error) {
+	var bucketInfo storageTypes.BucketInfo
+	if err := c.getVerifiedByID(ctx, storageTypes.StoreKey, storageTypes.GetBucketKey(bucketName),
+		storageTypes.GetBucketByIDKey, &bucketInfo, "bucket "+bucketName); err != nil {
+		return nil, err
+	}
+	return &bucketInfo, nil
+}
+
+// HeadObjectVerified queries the object info by the object id indexed by the bucket name and the object name
+func (c *client) HeadObjectVerified(ctx context.Context, bucketName, objectName string) (*storageTypes.ObjectInfo, error) {
+	var objectInfo storageTypes.ObjectInfo
+	if err := c.getVerifiedByID(ctx, storageTypes.StoreKey, storageTypes.GetObjectKey(bucketName, objectName),
+		storageTypes.GetObjectByIDKey, &objectInfo, "object "+bucketName+"/"+objectName); err != nil {
+		return nil, err
+	}
+	return &objectInfo, nil
+}
+
+// GetAccountPolicyVerified queries the policy by the policy id indexed by the resource and the account
+func (c *client) GetAccountPolicyVerified(ctx context.Context, resourceType resource.ResourceType, resourceID math.Uint, principalAddr string) (*permTypes.Policy, error) {
+	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+	if err != nil {
+		return nil, err
+	}
+	var policy permTypes.Policy
+	if err = c.getVerifiedByID(ctx, permTypes.StoreKey, permTypes.GetPolicyForAccountKey(resourceID, resourceType, addr),
+		permTypes.GetPolicyByIDKey, &policy, fmt.Sprintf("policy of %s on %s %s", principalAddr, resourceType, resourceID)); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
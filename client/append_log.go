@@ -0,0 +1,315 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// AppendLog provides the log sink semantics over the immutable objects. The writes go to the current object named
+// <name>-YYYYMMDD-N, which is uploaded when it is rotated by size, time or date, then it is recorded in the manifest
+// object <name>.manifest.json. The data written to the current object is only durable after it is rotated or the
+// log is closed. It implements io.WriteCloser, and it is safe for concurrent Write calls.
+type AppendLog struct {
+	ctx        context.Context
+	client     *client
+	bucketName string
+	name       string
+	opts       types.AppendLogOptions
+
+	mu       sync.Mutex
+	writer   *ObjectWriter
+	current  types.AppendLogObject
+	manifest *types.AppendLogManifest
+	// manifestExists indicates the manifest object may exist and should be removed before it is uploaded again
+	manifestExists bool
+	// manifestSealed indicates the manifest object is sealed, so the staged copy can be removed
+	manifestSealed bool
+	// stagedExists indicates the staged copy of the manifest may exist, a sealed staged copy is at least as recent as
+	// the manifest object
+	stagedExists bool
+	closed       bool
+	// nextSeq is the sequence of the next object of the date seqDate, it is advanced by every open, including the
+	// ones whose objects are dropped as they fail to be uploaded, since the objects may have been created on chain
+	seqDate string
+	nextSeq int
+}
+
+// OpenAppendLog loads the manifest of the log if it exists, the objects are appended after the ones in the manifest
+func (c *client) OpenAppendLog(ctx context.Context, bucketName, name string, opts types.AppendLogOptions) (*AppendLog, error) {
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := utils.VerifyObjectName(name + types.AppendLogStagedManifestSuffix); err != nil {
+		return nil, err
+	}
+	if opts.MaxObjectSize <= 0 {
+		opts.MaxObjectSize = types.DefaultAppendLogMaxObjectSize
+	}
+	if opts.RotateInterval < 0 {
+		return nil, fmt.Errorf("%w: RotateInterval %s is negative", types.ErrorInvalidOption, opts.RotateInterval)
+	}
+	manifest, exists, err := c.loadAppendLogManifest(ctx, bucketName, name+types.AppendLogManifestSuffix)
+	if err != nil {
+		return nil, err
+	}
+	sealed := manifest != nil
+	// the staged copy is left if the log is interrupted while the manifest is replaced, it is newer than the manifest
+	staged, stagedExists, err := c.loadAppendLogManifest(ctx, bucketName, name+types.AppendLogStagedManifestSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if staged != nil {
+		manifest = staged
+	} else if manifest == nil {
+		manifest = &types.AppendLogManifest{Version: types.AppendLogManifestVersion, BucketName: bucketName, Name: name}
+	}
+	return &AppendLog{
+		ctx:            ctx,
+		client:         c,
+		bucketName:     bucketName,
+		name:           name,
+		opts:           opts,
+		manifest:       manifest,
+		manifestExists: exists,
+		manifestSealed: sealed,
+		stagedExists:   stagedExists,
+	}, nil
+}
+
+// loadAppendLogManifest downloads the manifest object and returns whether it exists, nil is returned if it does not
+// exist or it is not sealed
+func (c *client) loadAppendLogManifest(ctx context.Context, bucketName, manifestName string) (*types.AppendLogManifest, bool, error) {
+	objectDetail, err := c.HeadObject(ctx, bucketName, manifestName)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if objectDetail.ObjectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+		return nil, true, nil
+	}
+	body, _, err := c.GetObject(ctx, bucketName, manifestName, types.GetObjectOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	defer body.Close()
+	manifest := &types.AppendLogManifest{}
+	if err = json.NewDecoder(body).Decode(manifest); err != nil {
+		return nil, false, fmt.Errorf("failed to decode the manifest %s: %w", manifestName, err)
+	}
+	if manifest.Version != types.AppendLogManifestVersion {
+		return nil, false, fmt.Errorf("unsupported version %d of the manifest %s", manifest.Version, manifestName)
+	}
+	return manifest, true, nil
+}
+
+// Write writes p into the current object, the object is rotated first if p makes it exceed the max size or it has
+// been open for the rotate interval
+func (l *AppendLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, errors.New("the append log is closed")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	now := l.client.now().UTC()
+	if l.writer != nil && l.shouldRotate(now, int64(len(p))) {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	if l.writer == nil {
+		if err := l.open(now); err != nil {
+			return 0, err
+		}
+	}
+	n, err := l.writer.Write(p)
+	l.current.Size += int64(n)
+	return n, err
+}
+
+// Rotate uploads the current object and records it in the manifest, it does nothing if no data is written since the
+// last rotation
+func (l *AppendLog) Rotate() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.writer == nil {
+		return nil
+	}
+	return l.rotate()
+}
+
+// Close rotates the current object and closes the log
+func (l *AppendLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	if l.writer == nil {
+		return nil
+	}
+	return l.rotate()
+}
+
+// Manifest returns a copy of the manifest, which does not include the current object
+func (l *AppendLog) Manifest() types.AppendLogManifest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	manifest := *l.manifest
+	manifest.Objects = append([]types.AppendLogObject(nil), l.manifest.Objects...)
+	return manifest
+}
+
+func (l *AppendLog) shouldRotate(now time.Time, size int64) bool {
+	if l.current.Size+size > l.opts.MaxObjectSize {
+		return true
+	}
+	if l.opts.RotateInterval > 0 && now.Sub(l.current.OpenedAt) >= l.opts.RotateInterval {
+		return true
+	}
+	return now.Format(types.AppendLogDateLayout) != l.current.OpenedAt.Format(types.AppendLogDateLayout)
+}
+
+// open starts the next object of the date. The sequence of the first object of the date follows the objects of the
+// date in the manifest and in the bucket, which include the ones dropped by the failed uploads before the log is opened.
+func (l *AppendLog) open(now time.Time) error {
+	date := now.Format(types.AppendLogDateLayout)
+	datePrefix := fmt.Sprintf("%s-%s-", l.name, date)
+	if l.seqDate != date {
+		nextSeq, err := l.nextSeqOfDate(datePrefix)
+		if err != nil {
+			return err
+		}
+		l.seqDate, l.nextSeq = date, nextSeq
+	}
+	objectName := fmt.Sprintf("%s%d", datePrefix, l.nextSeq)
+	l.nextSeq++
+	writer, err := l.client.NewObjectWriter(l.ctx, l.bucketName, objectName, l.opts.SpoolOptions)
+	if err != nil {
+		return err
+	}
+	l.writer = writer
+	l.current = types.AppendLogObject{ObjectName: objectName, OpenedAt: now}
+	return nil
+}
+
+// nextSeqOfDate returns the sequence following the objects of the date prefix in the manifest and in the bucket
+func (l *AppendLog) nextSeqOfDate(datePrefix string) (int, error) {
+	nextSeq := 0
+	follow := func(objectName string) {
+		seq, err := strconv.Atoi(strings.TrimPrefix(objectName, datePrefix))
+		if err == nil && seq >= nextSeq {
+			nextSeq = seq + 1
+		}
+	}
+	for _, object := range l.manifest.Objects {
+		if strings.HasPrefix(object.ObjectName, datePrefix) {
+			follow(object.ObjectName)
+		}
+	}
+	iter := l.client.ListObjectsIterator(l.ctx, l.bucketName, types.ListObjectsOptions{Prefix: datePrefix})
+	defer iter.Close()
+	for iter.Next() {
+		if objectInfo := iter.Value().ObjectInfo; objectInfo != nil {
+			follow(objectInfo.ObjectName)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return 0, fmt.Errorf("failed to list the log objects of %s: %w", datePrefix, err)
+	}
+	return nextSeq, nil
+}
+
+// rotate closes the current object and updates the manifest, the current object is dropped if its upload fails but
+// its sequence is not reused
+func (l *AppendLog) rotate() error {
+	writer := l.writer
+	l.writer = nil
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to upload the log object %s: %w", l.current.ObjectName, err)
+	}
+	l.current.ClosedAt = l.client.now().UTC()
+	l.current.TxnHash = writer.TxnHash()
+	l.manifest.Objects = append(l.manifest.Objects, l.current)
+	l.manifest.UpdatedAt = l.current.ClosedAt
+	return l.putManifest()
+}
+
+// putManifest replaces the manifest object. As the objects are immutable, the previous one has to be deleted before
+// the new one is uploaded, so the new manifest is sealed as the staged copy first and the staged copy is deleted
+// after the manifest object is sealed again. The log always keeps a sealed manifest or staged copy of the latest or
+// the previous version, and the staged copy is preferred when the log is opened.
+func (l *AppendLog) putManifest() error {
+	manifestName := l.name + types.AppendLogManifestSuffix
+	stagedName := l.name + types.AppendLogStagedManifestSuffix
+	data, err := json.Marshal(l.manifest)
+	if err != nil {
+		return err
+	}
+	if l.manifestSealed {
+		// the staged copy left by the previous update is not newer than the sealed manifest object
+		if l.stagedExists {
+			if err = l.removeManifest(stagedName); err != nil {
+				return err
+			}
+		}
+		l.stagedExists = true
+		if err = l.uploadManifest(stagedName, data); err != nil {
+			return err
+		}
+		l.manifestSealed = false
+	}
+	// the manifest object is not sealed here, the latest manifest is kept by the staged copy if it is uploaded before
+	if l.manifestExists {
+		if err = l.removeManifest(manifestName); err != nil {
+			return err
+		}
+	}
+	l.manifestExists = true
+	if err = l.uploadManifest(manifestName, data); err != nil {
+		return err
+	}
+	l.manifestSealed = true
+	if l.stagedExists {
+		if err = l.removeManifest(stagedName); err != nil {
+			return err
+		}
+		l.stagedExists = false
+	}
+	return nil
+}
+
+// uploadManifest uploads the manifest data as the object, which is sealed when it returns
+func (l *AppendLog) uploadManifest(objectName string, data []byte) error {
+	spoolOpts := l.opts.SpoolOptions
+	spoolOpts.CreateOpts.ContentType = "application/json"
+	if _, err := l.client.UploadStream(l.ctx, l.bucketName, objectName, bytes.NewReader(data), spoolOpts); err != nil {
+		return fmt.Errorf("failed to upload the manifest %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// removeManifest deletes the manifest object, or cancels it if its upload failed, and waits for the tx to succeed so
+// that the object can be created again
+func (l *AppendLog) removeManifest(objectName string) error {
+	if err := l.client.removeObjectForOverwrite(l.ctx, l.bucketName, objectName, l.opts.DeleteOpts); err != nil {
+		return fmt.Errorf("failed to delete the previous manifest %s: %w", objectName, err)
+	}
+	return nil
+}
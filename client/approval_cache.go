@@ -0,0 +1,66 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// cachedApproval is the JSON-encoded createBucket msg signed by the primary SP, it is decoded on every hit so the
+// callers never share a msg
+type cachedApproval struct {
+	signedMsg []byte
+	expiry    time.Time
+}
+
+// loadCachedApproval returns the cached approval of the unsigned createBucket msg if it has not expired
+func (c *client) loadCachedApproval(unsignedBytes []byte) (*storageTypes.MsgCreateBucket, bool) {
+	if c.approvalCacheTTL <= 0 {
+		return nil, false
+	}
+	key := approvalCacheKey(unsignedBytes)
+	c.approvalCacheMutex.Lock()
+	cached, ok := c.approvalCache[key]
+	c.approvalCacheMutex.Unlock()
+	if !ok || !c.now().Before(cached.expiry) {
+		return nil, false
+	}
+	var signedMsg storageTypes.MsgCreateBucket
+	if err := storageTypes.ModuleCdc.UnmarshalJSON(cached.signedMsg, &signedMsg); err != nil {
+		return nil, false
+	}
+	return &signedMsg, true
+}
+
+// cacheApproval caches the approval for approvalCacheTTL, the expired approvals are dropped
+func (c *client) cacheApproval(unsignedBytes, signedMsg []byte) {
+	if c.approvalCacheTTL <= 0 {
+		return
+	}
+	now := c.now()
+	c.approvalCacheMutex.Lock()
+	defer c.approvalCacheMutex.Unlock()
+	if c.approvalCache == nil {
+		c.approvalCache = make(map[string]*cachedApproval)
+	}
+	for key, cached := range c.approvalCache {
+		if !now.Before(cached.expiry) {
+			delete(c.approvalCache, key)
+		}
+	}
+	c.approvalCache[approvalCacheKey(unsignedBytes)] = &cachedApproval{signedMsg: signedMsg, expiry: now.Add(c.approvalCacheTTL)}
+}
+
+// dropCachedApproval removes the cached approval of the unsigned createBucket msg, e.g. it is expiring on chain
+func (c *client) dropCachedApproval(unsignedBytes []byte) {
+	c.approvalCacheMutex.Lock()
+	defer c.approvalCacheMutex.Unlock()
+	delete(c.approvalCache, approvalCacheKey(unsignedBytes))
+}
+
+func approvalCacheKey(unsignedBytes []byte) string {
+	hash := sha256.Sum256(unsignedBytes)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield/types/common"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// approvalExpiring reports whether the approval would expire before the tx broadcast now is included
+func (c *client) approvalExpiring(ctx context.Context, approval *common.Approval) (bool, error) {
+	height, err := c.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return false, err
+	}
+	return types.ApprovalExpiring(approval, height), nil
+}
+
+// refreshCreateBucketApproval requests the approval of the createBucket msg again if it is expiring, e.g. it was
+// cached or the broadcast was delayed by the retries, instead of broadcasting a tx which is rejected by the chain
+func (c *client) refreshCreateBucketApproval(ctx context.Context, signedMsg *storageTypes.MsgCreateBucket) (*storageTypes.MsgCreateBucket, error) {
+	expiring, err := c.approvalExpiring(ctx, signedMsg.PrimarySpApproval)
+	if err != nil || !expiring {
+		return signedMsg, err
+	}
+	unsignedMsg := *signedMsg
+	unsignedMsg.PrimarySpApproval = &common.Approval{}
+	c.dropCachedApproval(unsignedMsg.GetSignBytes())
+	log.Info().Msg(fmt.Sprintf("the approval of bucket %s is expiring, request it again", signedMsg.BucketName))
+	return c.GetCreateBucketApproval(ctx, &unsignedMsg)
+}
+
+// refreshCreateObjectApproval requests the approval of the createObject msg again if it is expiring
+func (c *client) refreshCreateObjectApproval(ctx context.Context, signedMsg *storageTypes.MsgCreateObject) (*storageTypes.MsgCreateObject, error) {
+	expiring, err := c.approvalExpiring(ctx, signedMsg.PrimarySpApproval)
+	if err != nil || !expiring {
+		return signedMsg, err
+	}
+	unsignedMsg := *signedMsg
+	unsignedMsg.PrimarySpApproval = &common.Approval{}
+	log.Info().Msg(fmt.Sprintf("the approval of object %s is expiring, request it again", signedMsg.ObjectName))
+	return c.GetCreateObjectApproval(ctx, &unsignedMsg)
+}
+
+// refreshMigrateBucketApproval requests the approval of the migrateBucket msg again if it is expiring
+func (c *client) refreshMigrateBucketApproval(ctx context.Context, signedMsg *storageTypes.MsgMigrateBucket) (*storageTypes.MsgMigrateBucket, error) {
+	expiring, err := c.approvalExpiring(ctx, signedMsg.DstPrimarySpApproval)
+	if err != nil || !expiring {
+		return signedMsg, err
+	}
+	unsignedMsg := *signedMsg
+	unsignedMsg.DstPrimarySpApproval = &common.Approval{}
+	log.Info().Msg(fmt.Sprintf("the migration approval of bucket %s is expiring, request it again", signedMsg.BucketName))
+	return c.GetMigrateBucketApproval(ctx, &unsignedMsg)
+}
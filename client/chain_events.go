@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// the queries of the txs emitting the events of the storage providers, the queries can not be combined by OR
+var spEventQueries = []string{
+	txEventQuery("greenfield.sp.EventCreateStorageProvider", "sp_id"),
+	txEventQuery("greenfield.sp.EventEditStorageProvider", "sp_id"),
+	txEventQuery("greenfield.sp.EventUpdateStorageProviderStatus", "sp_id"),
+}
+
+// the typed events of the storage module which change the status of an object
+var objectEventTypes = []string{
+	"greenfield.storage.EventCreateObject",
+	"greenfield.storage.EventSealObject",
+	"greenfield.storage.EventRejectSealObject",
+	"greenfield.storage.EventCancelCreateObject",
+	"greenfield.storage.EventDeleteObject",
+}
+
+// objectEventQuery returns the query of the committed txs emitting the typed event of the objects in the bucket. The
+// attributes of the typed events are JSON values, which can not be put in the query as they are quoted, so the query
+// may match the events of other buckets, the events of the object are picked by isObjectEvent.
+func objectEventQuery(eventType, bucketName string) string {
+	return fmt.Sprintf("%s='%s' AND %s.bucket_name CONTAINS '%s'", bfttypes.EventTypeKey, bfttypes.EventTx, eventType, bucketName)
+}
+
+// isObjectEvent checks whether any of the typed events is an event of the object
+func isObjectEvent(typedEvents []proto.Message, bucketName, objectName string) bool {
+	for _, typedEvent := range typedEvents {
+		event, ok := typedEvent.(interface {
+			GetBucketName() string
+			GetObjectName() string
+		})
+		if ok && event.GetBucketName() == bucketName && event.GetObjectName() == objectName {
+			return true
+		}
+	}
+	return false
+}
+
+// txEventQuery returns the query of the committed txs emitting the typed event which has the attribute
+func txEventQuery(eventType, attribute string) string {
+	return fmt.Sprintf("%s='%s' AND %s.%s EXISTS", bfttypes.EventTypeKey, bfttypes.EventTx, eventType, attribute)
+}
+
+// chainEvents shares the subscriptions of the tx events over the websocket connection among the listeners, as the
+// rpc client keeps a single channel per query
+type chainEvents struct {
+	rpc *rpchttp.HTTP
+	// ctx is canceled by closing the client, which stops the dispatching goroutines
+	ctx context.Context
+
+	mu            sync.Mutex
+	subscriptions map[string]*eventSubscription
+	nextID        uint64
+}
+
+// eventSubscription is the subscription of a query and its listeners
+type eventSubscription struct {
+	listeners map[uint64]func(typedEvents []proto.Message)
+	stop      chan struct{}
+}
+
+// newChainEvents connects to the websocket endpoint of the chain rpc, the connection is closed by close
+func newChainEvents(ctx context.Context, endpoint string, proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config) (*chainEvents, error) {
+	httpClient, err := newChainHTTPClient(endpoint, proxy, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	rpc, err := rpchttp.NewWithClient(endpoint, "/websocket", httpClient)
+	if err != nil {
+		return nil, err
+	}
+	if err = rpc.Start(); err != nil {
+		return nil, fmt.Errorf("fail to connect to the websocket endpoint of %s: %w", endpoint, err)
+	}
+	return &chainEvents{
+		rpc:           rpc,
+		ctx:           ctx,
+		subscriptions: make(map[string]*eventSubscription),
+	}, nil
+}
+
+// subscribe calls the listener with the typed events of every committed tx matching the query until the returned
+// function is called to unsubscribe. The listeners of a query are called by a single goroutine and should not block.
+func (e *chainEvents) subscribe(ctx context.Context, query string, listener func(typedEvents []proto.Message)) (func(), error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	sub, ok := e.subscriptions[query]
+	if !ok {
+		out, err := e.rpc.Subscribe(ctx, types.ChainEventSubscriber, query, types.ChainEventBufferSize)
+		if err != nil {
+			return nil, err
+		}
+		sub = &eventSubscription{
+			listeners: make(map[uint64]func(typedEvents []proto.Message)),
+			stop:      make(chan struct{}),
+		}
+		e.subscriptions[query] = sub
+		go e.dispatch(query, sub, out)
+	}
+	id := e.nextID
+	e.nextID++
+	sub.listeners[id] = listener
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			e.unsubscribe(query, id)
+		})
+	}, nil
+}
+
+// unsubscribe removes the listener of the query, the query is unsubscribed when it has no listener
+func (e *chainEvents) unsubscribe(query string, id uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	sub, ok := e.subscriptions[query]
+	if !ok {
+		return
+	}
+	delete(sub.listeners, id)
+	if len(sub.listeners) > 0 {
+		return
+	}
+	close(sub.stop)
+	delete(e.subscriptions, query)
+	if e.ctx.Err() != nil {
+		return
+	}
+	if err := e.rpc.Unsubscribe(context.Background(), types.ChainEventSubscriber, query); err != nil {
+		log.Error().Msg(fmt.Sprintf("fail to unsubscribe the chain events of %s, err: %s", query, err.Error()))
+	}
+}
+
+// dispatch decodes the typed events of the txs received from the subscription and calls the listeners with them
+func (e *chainEvents) dispatch(query string, sub *eventSubscription, out <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-sub.stop:
+			return
+		case result := <-out:
+			data, ok := result.Data.(bfttypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			typedEvents, err := events.ParseEvents(data.Result.Events)
+			if err != nil {
+				log.Error().Msg(fmt.Sprintf("fail to parse the chain events of %s, err: %s", query, err.Error()))
+				continue
+			}
+			e.mu.Lock()
+			listeners := make([]func(typedEvents []proto.Message), 0, len(sub.listeners))
+			for _, listener := range sub.listeners {
+				listeners = append(listeners, listener)
+			}
+			e.mu.Unlock()
+			for _, listener := range listeners {
+				listener(typedEvents)
+			}
+		}
+	}
+}
+
+// close closes the websocket connection, the dispatching goroutines are stopped by canceling the context
+func (e *chainEvents) close() error {
+	return e.rpc.Stop()
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"testing"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectEventQuery(t *testing.T) {
+	eventType := "greenfield.storage.EventSealObject"
+	q, err := query.New(objectEventQuery(eventType, "bucket"))
+	require.NoError(t, err)
+
+	matched, err := q.Matches(map[string][]string{
+		"tm.event":                 {"Tx"},
+		eventType + ".bucket_name": {`"bucket"`},
+	})
+	require.NoError(t, err)
+	require.True(t, matched)
+
+	matched, err = q.Matches(map[string][]string{
+		"tm.event":                 {"Tx"},
+		eventType + ".bucket_name": {`"other"`},
+	})
+	require.NoError(t, err)
+	require.False(t, matched)
+}
+
+func TestIsObjectEvent(t *testing.T) {
+	typedEvents := []proto.Message{
+		&storageTypes.EventCreateBucket{BucketName: "bucket"},
+		&storageTypes.EventSealObject{BucketName: "bucket", ObjectName: "dir/object"},
+	}
+	require.True(t, isObjectEvent(typedEvents, "bucket", "dir/object"))
+	require.False(t, isObjectEvent(typedEvents, "bucket", "dir"))
+	require.False(t, isObjectEvent(typedEvents, "bucket2", "dir/object"))
+}
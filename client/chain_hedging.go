@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// hedgingUnaryInterceptor sends the hedged calls to the secondary connection as well if the primary connection does
+// not respond in the delay of the policy. The first successful response is copied into the reply. A failed response
+// is returned at once if the call has not been hedged yet, otherwise the response of the other connection is waited.
+func hedgingUnaryInterceptor(policy *types.HedgingPolicy, secondary *grpc.ClientConn) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !policy.Hedged(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		// the call which is not taken is canceled on return
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			reply interface{}
+			err   error
+		}
+		// buffered for both calls so that the call which is not taken does not block
+		results := make(chan result, 2)
+		call := func(invoke func(reply interface{}) error) {
+			r := reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+			results <- result{reply: r, err: invoke(r)}
+		}
+		go call(func(r interface{}) error {
+			return invoker(ctx, method, req, r, cc, opts...)
+		})
+
+		timer := time.NewTimer(policy.Delay)
+		defer timer.Stop()
+		hedgeC := timer.C
+		pending := 1
+		for {
+			select {
+			case <-hedgeC:
+				hedgeC = nil
+				pending++
+				go call(func(r interface{}) error {
+					return secondary.Invoke(ctx, method, req, r, opts...)
+				})
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(res.reply).Elem())
+					return nil
+				}
+				if hedgeC != nil || pending == 0 {
+					return res.err
+				}
+			}
+		}
+	}
+}
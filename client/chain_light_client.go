@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/url"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// newLightClient returns the light client verifying the headers of the endpoint against the witnesses, and the rpc
+// client of the endpoint which queries the state with the proofs. The verified headers are kept in memory.
+func newLightClient(ctx context.Context, chainID, endpoint string, opts types.LightClientOptions,
+	proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config,
+) (*light.Client, *rpchttp.HTTP, error) {
+	if len(opts.Witnesses) == 0 {
+		return nil, nil, errors.New("the light client requires at least one witness")
+	}
+	newRPCClient := func(remote string) (*rpchttp.HTTP, error) {
+		httpClient, err := newChainHTTPClient(remote, proxy, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		return rpchttp.NewWithClient(remote, "/websocket", httpClient)
+	}
+	primary, err := newRPCClient(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	witnesses := make([]provider.Provider, 0, len(opts.Witnesses))
+	for _, witness := range opts.Witnesses {
+		rpcClient, err := newRPCClient(witness)
+		if err != nil {
+			return nil, nil, err
+		}
+		witnesses = append(witnesses, lighthttp.NewWithClient(chainID, rpcClient))
+	}
+	lightClient, err := light.NewClient(ctx, chainID, light.TrustOptions{
+		Period: opts.TrustPeriod,
+		Height: opts.TrustedHeight,
+		Hash:   opts.TrustedHash,
+	}, lighthttp.NewWithClient(chainID, primary), witnesses, lightdb.New(dbm.NewMemDB(), chainID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return lightClient, primary, nil
+}
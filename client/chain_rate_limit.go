@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+)
+
+// rateLimitUnaryInterceptor waits for a token of the limiter before each gRPC call to the chain node
+func rateLimitUnaryInterceptor(limiter *utils.RateLimiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// rateLimitMiddleware waits for a token of the limiter before each request sent to the chain rpc
+func rateLimitMiddleware(limiter *utils.RateLimiter) TransportMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// skipSyncGuardKey marks the context of the requests sent by the sync guard itself
+type skipSyncGuardKey struct{}
+
+// syncGuard refuses the requests sent to the chain node which is lagging more than the max block lag, the sync
+// status is checked at most once per check interval
+type syncGuard struct {
+	opts types.SyncGuardOptions
+	// client is set once the client is constructed
+	client *client
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	err       error
+}
+
+func newSyncGuard(opts types.SyncGuardOptions) (*syncGuard, error) {
+	if opts.MaxBlockLag <= 0 {
+		return nil, fmt.Errorf("the max block lag of the sync guard should be positive, got %d", opts.MaxBlockLag)
+	}
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = types.DefaultSyncGuardCheckInterval
+	}
+	return &syncGuard{opts: opts}, nil
+}
+
+// check returns types.ErrorNodeLagging if the node is catching up or lagging more than the max block lag. The
+// concurrent requests wait for the ongoing check instead of checking again.
+func (g *syncGuard) check(ctx context.Context) error {
+	if ctx.Value(skipSyncGuardKey{}) != nil || g.client == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.checkedAt.IsZero() && time.Since(g.checkedAt) < g.opts.CheckInterval {
+		return g.err
+	}
+
+	status, err := g.client.GetSyncStatus(context.WithValue(ctx, skipSyncGuardKey{}, true))
+	if err != nil {
+		// the failure of the check is not cached, as it may be caused by the context of the request
+		return err
+	}
+	switch {
+	case status.CatchingUp:
+		g.err = fmt.Errorf("%w: the node is catching up at height %d", types.ErrorNodeLagging, status.LatestBlockHeight)
+	case status.BlocksBehind > g.opts.MaxBlockLag:
+		g.err = fmt.Errorf("%w: the node is about %d blocks behind at height %d, the max block lag is %d",
+			types.ErrorNodeLagging, status.BlocksBehind, status.LatestBlockHeight, g.opts.MaxBlockLag)
+	default:
+		g.err = nil
+	}
+	g.checkedAt = time.Now()
+	return g.err
+}
+
+// unaryInterceptor checks the sync status before each gRPC call to the chain node
+func (g *syncGuard) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := g.check(ctx); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// middleware checks the sync status before each request sent to the chain rpc
+func (g *syncGuard) middleware() TransportMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if err := g.check(req.Context()); err != nil {
+				return nil, err
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// LoadConfig reads the client config from the file, whose format is decided by the extension, i.e. ".toml" for TOML
+// and ".yaml", ".yml" or ".json" for YAML
+func LoadConfig(path string) (*types.ClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := types.ParseClientConfig(data, strings.TrimPrefix(filepath.Ext(path), "."))
+	if err != nil {
+		return nil, fmt.Errorf("invalid client config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// NewFromConfig instantiates the client by the config, e.g. loaded by LoadConfig. The settings of the config override
+// the ones of the option, and the option provides the settings which can not be written in the config, e.g. the
+// transport. The log level of the config is applied to the global zerolog logger.
+func NewFromConfig(config *types.ClientConfig, option Option) (Client, error) {
+	chainID, rpcAddress, option, err := optionFromConfig(config, option)
+	if err != nil {
+		return nil, err
+	}
+	return New(chainID, rpcAddress, option)
+}
+
+// optionFromConfig returns the chain id, the rpc address and the option overridden by the settings of the config
+func optionFromConfig(config *types.ClientConfig, option Option) (string, string, Option, error) {
+	if err := config.Validate(); err != nil {
+		return "", "", option, err
+	}
+	chainID, rpcAddress, grpcAddress := config.ChainID, config.RpcAddress, config.GrpcAddress
+	if config.Preset != "" {
+		preset, err := types.GetNetworkPreset(config.Preset)
+		if err != nil {
+			return "", "", option, err
+		}
+		if chainID == "" {
+			chainID = preset.ChainID
+		}
+		if rpcAddress == "" {
+			rpcAddress = preset.RpcAddress
+		}
+		if grpcAddress == "" {
+			grpcAddress = preset.GrpcAddress
+		}
+	}
+	if grpcAddress != "" {
+		option.GrpcAddress = grpcAddress
+	}
+
+	if config.Key != nil {
+		account, err := config.Key.LoadAccount()
+		if err != nil {
+			return "", "", option, err
+		}
+		option.DefaultAccount = account
+	}
+	if len(config.SPEndpoints) > 0 {
+		option.SPEndpointResolver = types.StaticSPEndpointResolver(config.SPEndpoints)
+	}
+	setDuration := func(target *time.Duration, duration types.Duration) {
+		if duration != 0 {
+			*target = time.Duration(duration)
+		}
+	}
+	setDuration(&option.DialTimeout, config.Timeouts.Dial)
+	setDuration(&option.ResponseHeaderTimeout, config.Timeouts.ResponseHeader)
+	setDuration(&option.RequestTimeout, config.Timeouts.Request)
+	setDuration(&option.ApprovalTimeout, config.Timeouts.Approval)
+	setDuration(&option.UploadBackOffDelay, config.Retry.UploadBackoff)
+	if config.Retry.UploadMaxAttempts != 0 {
+		option.UploadMaxTryTime = config.Retry.UploadMaxAttempts
+	}
+	if config.ChainRateLimit != nil {
+		option.ChainRateLimit = &types.RateLimitOptions{QPS: config.ChainRateLimit.QPS, Burst: config.ChainRateLimit.Burst}
+	}
+	if config.UserAgentSuffix != "" {
+		option.UserAgentSuffix = config.UserAgentSuffix
+	}
+	if config.AppID != "" {
+		option.AppID = config.AppID
+	}
+
+	if config.Log.Level != "" {
+		level, err := zerolog.ParseLevel(config.Log.Level)
+		if err != nil {
+			return "", "", option, fmt.Errorf("%w: invalid log level %q", types.ErrorInvalidOption, config.Log.Level)
+		}
+		zerolog.SetGlobalLevel(level)
+	}
+	return chainID, rpcAddress, option, nil
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfigRetry(t *testing.T) {
+	for _, path := range []string{"testdata/client_config.yaml", "testdata/client_config.toml"} {
+		config, err := LoadConfig(path)
+		require.NoError(t, err, path)
+		require.Equal(t, 5, config.Retry.UploadMaxAttempts, path)
+		require.Equal(t, 2*time.Second, time.Duration(config.Retry.UploadBackoff), path)
+
+		chainID, _, option, err := optionFromConfig(config, Option{})
+		require.NoError(t, err, path)
+		require.Equal(t, "greenfield_5600-1", chainID, path)
+		require.Equal(t, 5, option.UploadMaxTryTime, path)
+		require.Equal(t, 2*time.Second, option.UploadBackOffDelay, path)
+		require.Equal(t, 30*time.Second, option.RequestTimeout, path)
+	}
+}
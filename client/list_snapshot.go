@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ListObjectsAtHeight pages the objects of the bucket from the chain with every page pinned to the same height
+func (c *client) ListObjectsAtHeight(ctx context.Context, bucketName string, height int64) *types.Iterator[*storageTypes.ObjectInfo] {
+	pager := &snapshotPager{client: c, height: height}
+	return types.NewIterator(func() ([]*storageTypes.ObjectInfo, bool, error) {
+		queryCtx, err := pager.pin(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		resp, err := c.chainClient.ListObjects(queryCtx, &storageTypes.QueryListObjectsRequest{
+			BucketName: bucketName,
+			Pagination: pager.pageRequest(),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.ObjectInfos, pager.advance(resp.Pagination), nil
+	})
+}
+
+// ListBucketsAtHeight pages all the buckets of the chain with every page pinned to the same height, the buckets of
+// other owners are skipped on the client side
+func (c *client) ListBucketsAtHeight(ctx context.Context, ownerAddr string, height int64) *types.Iterator[*storageTypes.BucketInfo] {
+	var (
+		owner    sdk.AccAddress
+		ownerErr error
+	)
+	if ownerAddr != "" {
+		owner, ownerErr = sdk.AccAddressFromHexUnsafe(ownerAddr)
+	}
+	pager := &snapshotPager{client: c, height: height}
+	return types.NewIterator(func() ([]*storageTypes.BucketInfo, bool, error) {
+		if ownerErr != nil {
+			return nil, false, ownerErr
+		}
+		queryCtx, err := pager.pin(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		resp, err := c.chainClient.ListBuckets(queryCtx, &storageTypes.QueryListBucketsRequest{Pagination: pager.pageRequest()})
+		if err != nil {
+			return nil, false, err
+		}
+		buckets := resp.BucketInfos
+		if owner != nil {
+			buckets = make([]*storageTypes.BucketInfo, 0, len(resp.BucketInfos))
+			for _, bucketInfo := range resp.BucketInfos {
+				if strings.EqualFold(bucketInfo.Owner, owner.String()) {
+					buckets = append(buckets, bucketInfo)
+				}
+			}
+		}
+		return buckets, pager.advance(resp.Pagination), nil
+	})
+}
+
+// snapshotPager tracks the pinned height and the key of the next page of a listing
+type snapshotPager struct {
+	client  *client
+	height  int64
+	nextKey []byte
+}
+
+// pin returns the context pinned to the height, the latest height is resolved on the first page if it is not set
+func (p *snapshotPager) pin(ctx context.Context) (context.Context, error) {
+	if p.height == 0 {
+		height, err := p.client.GetLatestBlockHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.height = height
+	}
+	return types.WithQueryHeight(ctx, p.height), nil
+}
+
+func (p *snapshotPager) pageRequest() *query.PageRequest {
+	return &query.PageRequest{Key: p.nextKey, Limit: types.SnapshotListPageSize}
+}
+
+// advance records the key of the next page and returns whether there are more pages
+func (p *snapshotPager) advance(pageResponse *query.PageResponse) bool {
+	if pageResponse == nil || len(pageResponse.NextKey) == 0 {
+		return false
+	}
+	p.nextKey = pageResponse.NextKey
+	return true
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield/types/common"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ListPendingObjects returns the approvals left in the approval store, i.e. the objects whose creation or upload has
+// not finished, e.g. the worker crashed. It returns nothing if no approval store is configured.
+func (c *client) ListPendingObjects(ctx context.Context) ([]*types.StoredApproval, error) {
+	if c.approvalStore == nil {
+		return nil, nil
+	}
+	return c.approvalStore.List()
+}
+
+// loadApproval returns the stored approval of the createObject msg if it is still valid, i.e. the msg is unchanged
+// apart from the approval and the approval has not expired. A stale approval is ignored and replaced when the new
+// approval is saved.
+func (c *client) loadApproval(ctx context.Context, createObjectMsg *storageTypes.MsgCreateObject) (*storageTypes.MsgCreateObject, *types.StoredApproval, error) {
+	stored, err := c.approvalStore.Load(createObjectMsg.BucketName, createObjectMsg.ObjectName)
+	if err != nil {
+		if errors.Is(err, types.ErrorApprovalNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	signedMsg, err := stored.Msg()
+	if err != nil {
+		log.Warn().Msg(fmt.Sprintf("ignore the undecodable approval of object %s: %s", createObjectMsg.ObjectName, err.Error()))
+		return nil, nil, nil
+	}
+	if !sameCreateObjectMsg(signedMsg, createObjectMsg) {
+		return nil, nil, nil
+	}
+
+	// the approval of the broadcast msg is kept to resume the flow even if it has expired, as the object may be created
+	if stored.TxHash == "" {
+		expiring, err := c.approvalExpiring(ctx, signedMsg.PrimarySpApproval)
+		if err != nil {
+			return nil, nil, err
+		}
+		if expiring {
+			return nil, nil, nil
+		}
+	}
+	return signedMsg, stored, nil
+}
+
+// saveApproval persists the signed createObject msg, the hash of the tx is recorded once it is broadcast
+func (c *client) saveApproval(signedMsg *storageTypes.MsgCreateObject, txHash string) error {
+	approval, err := types.NewStoredApproval(signedMsg, c.now())
+	if err != nil {
+		return err
+	}
+	approval.TxHash = txHash
+	return c.approvalStore.Save(approval)
+}
+
+// forgetApproval deletes the approval of the object whose flow has finished
+func (c *client) forgetApproval(bucketName, objectName string) {
+	if c.approvalStore == nil {
+		return
+	}
+	if err := c.approvalStore.Delete(bucketName, objectName); err != nil {
+		log.Warn().Msg(fmt.Sprintf("fail to delete the approval of object %s: %s", objectName, err.Error()))
+	}
+}
+
+// sameCreateObjectMsg compares the createObject msgs regardless of the approvals of the primary SP
+func sameCreateObjectMsg(a, b *storageTypes.MsgCreateObject) bool {
+	aCopy, bCopy := *a, *b
+	aCopy.PrimarySpApproval, bCopy.PrimarySpApproval = &common.Approval{}, &common.Approval{}
+	return bytes.Equal(aCopy.GetSignBytes(), bCopy.GetSignBytes())
+}
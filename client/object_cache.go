@@ -0,0 +1,200 @@
+package client
+
+import (
+	"container/list"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// objectCacheTempSuffix is the suffix of the cache files being filled, which are removed when the cache is loaded
+const objectCacheTempSuffix = ".filling"
+
+// objectDiskCache is a size-bounded LRU cache of the object payloads on the local disk. The entries are keyed by the
+// object id and the primary checksum, so an updated object never hits the stale payload.
+type objectDiskCache struct {
+	dir     string
+	maxSize int64
+
+	mutex   sync.Mutex
+	size    int64
+	lru     *list.List // the front is the most recently used entry
+	entries map[string]*list.Element
+}
+
+type objectCacheEntry struct {
+	key  string
+	size int64
+}
+
+// newObjectDiskCache creates the cache in dir and loads the existing entries, the recency of which is restored from
+// the modification time of the files
+func newObjectDiskCache(dir string, maxSize int64) (*objectDiskCache, error) {
+	if maxSize <= 0 {
+		maxSize = types.DefaultObjectCacheMaxSize
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &objectDiskCache{
+		dir:     dir,
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+	var infos []os.FileInfo
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(dirEntry.Name(), objectCacheTempSuffix) {
+			os.Remove(filepath.Join(dir, dirEntry.Name()))
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().After(infos[j].ModTime())
+	})
+	for _, info := range infos {
+		cache.entries[info.Name()] = cache.lru.PushBack(&objectCacheEntry{key: info.Name(), size: info.Size()})
+		cache.size += info.Size()
+	}
+	cache.mutex.Lock()
+	cache.evictLocked()
+	cache.mutex.Unlock()
+	return cache, nil
+}
+
+// objectCacheKey returns the cache key of the object payload
+func objectCacheKey(objectID string, checksum []byte) string {
+	return objectID + "-" + hex.EncodeToString(checksum)
+}
+
+// get opens the cached payload of the key
+func (cache *objectDiskCache) get(key string) (*os.File, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	elem, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	path := filepath.Join(cache.dir, key)
+	fd, err := os.Open(path)
+	if err != nil {
+		cache.removeLocked(elem)
+		return nil, false
+	}
+	cache.lru.MoveToFront(elem)
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return fd, true
+}
+
+// fill returns the reader which copies the body into the cache while it is read, the payload is added to the cache
+// only if the body is read to the end with the expected size
+func (cache *objectDiskCache) fill(key string, body io.ReadCloser, expectedSize int64) io.ReadCloser {
+	if expectedSize > cache.maxSize {
+		return body
+	}
+	fd, err := os.CreateTemp(cache.dir, key+"-*"+objectCacheTempSuffix)
+	if err != nil {
+		log.Warn().Msgf("failed to create the object cache file of %s: %v", key, err)
+		return body
+	}
+	return &cacheFillReader{cache: cache, key: key, body: body, file: fd, expectedSize: expectedSize}
+}
+
+// add moves the filled file into the cache and evicts the least recently used entries beyond the max size
+func (cache *objectDiskCache) add(key string, tempPath string, size int64) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if elem, ok := cache.entries[key]; ok {
+		cache.removeLocked(elem)
+	}
+	if err := os.Rename(tempPath, filepath.Join(cache.dir, key)); err != nil {
+		os.Remove(tempPath)
+		return
+	}
+	cache.entries[key] = cache.lru.PushFront(&objectCacheEntry{key: key, size: size})
+	cache.size += size
+	cache.evictLocked()
+}
+
+func (cache *objectDiskCache) evictLocked() {
+	for cache.size > cache.maxSize && cache.lru.Len() > 0 {
+		cache.removeLocked(cache.lru.Back())
+	}
+}
+
+func (cache *objectDiskCache) removeLocked(elem *list.Element) {
+	entry := elem.Value.(*objectCacheEntry)
+	cache.lru.Remove(elem)
+	delete(cache.entries, entry.key)
+	cache.size -= entry.size
+	os.Remove(filepath.Join(cache.dir, entry.key))
+}
+
+// cacheFillReader tees the downloaded payload into the cache file
+type cacheFillReader struct {
+	cache        *objectDiskCache
+	key          string
+	body         io.ReadCloser
+	file         *os.File
+	written      int64
+	expectedSize int64
+	failed       bool
+	done         bool
+}
+
+func (r *cacheFillReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	if n > 0 && !r.failed {
+		if _, writeErr := r.file.Write(p[:n]); writeErr != nil {
+			r.failed = true
+		}
+		r.written += int64(n)
+	}
+	if errors.Is(err, io.EOF) {
+		r.finish(!r.failed && r.written == r.expectedSize)
+	}
+	return n, err
+}
+
+func (r *cacheFillReader) Close() error {
+	// the partially read payload is not cached
+	r.finish(false)
+	return r.body.Close()
+}
+
+func (r *cacheFillReader) finish(complete bool) {
+	if r.done {
+		return
+	}
+	r.done = true
+	tempPath := r.file.Name()
+	if err := r.file.Close(); err != nil || !complete {
+		os.Remove(tempPath)
+		return
+	}
+	r.cache.add(r.key, tempPath, r.written)
+}
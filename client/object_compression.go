@@ -0,0 +1,152 @@
+package client
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// compressPayload compresses the payload into a temp file and returns the file rewound to the beginning and the
+// compressed size. The output is deterministic, so the payload compressed for CreateObject and PutObject matches.
+// The caller should close and remove the file.
+func compressPayload(reader io.Reader, compression types.CompressionType) (*os.File, int64, error) {
+	fd, err := os.CreateTemp("", "gnfd-compress-*")
+	if err != nil {
+		return nil, 0, err
+	}
+	cleanup := func() {
+		fd.Close()
+		os.Remove(fd.Name())
+	}
+
+	var writer io.WriteCloser
+	switch compression {
+	case types.CompressionGzip:
+		writer = gzip.NewWriter(fd)
+	case types.CompressionZstd:
+		// the concurrent encoder is avoided to keep the output deterministic
+		writer, err = zstd.NewWriter(fd, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			cleanup()
+			return nil, 0, err
+		}
+	default:
+		cleanup()
+		return nil, 0, fmt.Errorf("unsupported compression type %s", compression)
+	}
+
+	if _, err = io.Copy(writer, reader); err != nil {
+		writer.Close()
+		cleanup()
+		return nil, 0, err
+	}
+	if err = writer.Close(); err != nil {
+		cleanup()
+		return nil, 0, err
+	}
+	size, err := fd.Seek(0, io.SeekCurrent)
+	if err != nil {
+		cleanup()
+		return nil, 0, err
+	}
+	if _, err = fd.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, 0, err
+	}
+	return fd, size, nil
+}
+
+// removeTempFile closes and removes the temp file created by compressPayload
+func removeTempFile(fd *os.File) {
+	fd.Close()
+	os.Remove(fd.Name())
+}
+
+// withContentEncoding records the compression in the content type
+func withContentEncoding(contentType string, compression types.CompressionType) string {
+	if compression == types.CompressionNone {
+		return contentType
+	}
+	if contentType == "" {
+		contentType = types.ContentDefault
+	}
+	return contentType + "; " + types.ContentEncodingParam + "=" + string(compression)
+}
+
+// decompressReader wraps the body to return the decompressed payload, closing it closes the body
+type decompressReader struct {
+	io.Reader
+	decoder io.Closer
+	body    io.Closer
+}
+
+func (r *decompressReader) Close() error {
+	if r.decoder != nil {
+		r.decoder.Close()
+	}
+	return r.body.Close()
+}
+
+// newDecompressReader returns the reader of the decompressed payload of the body
+func newDecompressReader(body io.ReadCloser, compression types.CompressionType) (io.ReadCloser, error) {
+	switch compression {
+	case types.CompressionGzip:
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressReader{Reader: gzipReader, decoder: gzipReader, body: body}, nil
+	case types.CompressionZstd:
+		zstdReader, err := zstd.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressReader{Reader: zstdReader, decoder: zstdReaderCloser{zstdReader}, body: body}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression type %s", compression)
+	}
+}
+
+// decompressFile decompresses the payload of the src file into the file of dstPath
+func decompressFile(src *os.File, dstPath string, compression types.CompressionType) error {
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader, err := newDecompressReader(io.NopCloser(src), compression)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	tempFilePath := dstPath + ".decompress" + types.TempFileSuffix
+	dst, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, types.FilePermMode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, reader); err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFilePath)
+		return err
+	}
+	return os.Rename(tempFilePath, dstPath)
+}
+
+// zstdReaderCloser adapts the Close of zstd.Decoder which returns nothing
+type zstdReaderCloser struct {
+	decoder *zstd.Decoder
+}
+
+func (z zstdReaderCloser) Close() error {
+	z.decoder.Close()
+	return nil
+}
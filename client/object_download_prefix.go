@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// pendingDownload is an object being opened ahead of the consumer, done is closed when download or err is set
+type pendingDownload struct {
+	done     chan struct{}
+	download *types.ObjectDownload
+	err      error
+}
+
+// DownloadPrefix lists the objects in a background goroutine and opens each of them in its own goroutine, the pending
+// downloads are queued in the listing order and the queue is bounded by the prefetch
+func (c *client) DownloadPrefix(ctx context.Context, bucketName, prefix string, opts types.DownloadPrefixOptions) *types.Iterator[*types.ObjectDownload] {
+	if err := opts.Validate(); err != nil {
+		return types.NewIterator(func() ([]*types.ObjectDownload, bool, error) {
+			return nil, false, err
+		})
+	}
+	prefetch := opts.Prefetch
+	if prefetch == 0 {
+		prefetch = types.DefaultDownloadPrefetch
+	}
+
+	// the listing is stopped by closing stop rather than canceling ctx, so that the bodies already returned to the
+	// consumer can still be read after the iterator is closed
+	var (
+		queue    = make(chan *pendingDownload, prefetch)
+		stop     = make(chan struct{})
+		stopOnce sync.Once
+	)
+	enqueue := func(pending *pendingDownload) bool {
+		select {
+		case queue <- pending:
+			return true
+		case <-stop:
+			return false
+		}
+	}
+	go func() {
+		defer close(queue)
+		iter := c.ListObjectsIterator(ctx, bucketName, types.ListObjectsOptions{Prefix: prefix, EndPointOptions: opts.EndPointOptions})
+		defer iter.Close()
+		for iter.Next() {
+			objectMeta := iter.Value()
+			if objectMeta.ObjectInfo == nil || objectMeta.ObjectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+				continue
+			}
+			pending := &pendingDownload{done: make(chan struct{})}
+			if !enqueue(pending) {
+				return
+			}
+			go func() {
+				defer close(pending.done)
+				objectName := objectMeta.ObjectInfo.ObjectName
+				body, stat, err := c.GetObject(ctx, bucketName, objectName, opts.GetObjectOptions)
+				if err != nil {
+					pending.err = fmt.Errorf("failed to download object %s: %w", objectName, err)
+					return
+				}
+				pending.download = &types.ObjectDownload{Object: objectMeta, Stat: stat, Body: body}
+			}()
+		}
+		if err := iter.Err(); err != nil {
+			pending := &pendingDownload{done: make(chan struct{}), err: err}
+			close(pending.done)
+			enqueue(pending)
+		}
+	}()
+
+	fetch := func() ([]*types.ObjectDownload, bool, error) {
+		pending, ok := <-queue
+		if !ok {
+			return nil, false, nil
+		}
+		<-pending.done
+		if pending.err != nil {
+			return nil, false, pending.err
+		}
+		return []*types.ObjectDownload{pending.download}, true, nil
+	}
+	onClose := func() error {
+		stopOnce.Do(func() {
+			close(stop)
+			go func() {
+				for pending := range queue {
+					<-pending.done
+					if pending.download != nil {
+						pending.download.Body.Close()
+					}
+				}
+			}()
+		})
+		return nil
+	}
+	return types.NewIteratorWithCloser(fetch, onClose)
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ComputeHashRootsWithProgress reads the segments in order and hashes them by a worker per CPU, at most two segments
+// per worker are kept in memory unless they are bounded by Option.HashMaxBufferedSegments
+func (c *client) ComputeHashRootsWithProgress(reader io.Reader, size int64, onProgress types.HashProgressFunc) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	if reader == nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, errors.New("fail to compute hash, reader is nil")
+	}
+	dataBlocks, parityBlocks, segSize, err := c.GetRedundancyParams()
+	if err != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, err
+	}
+	return computeHashRootsParallel(reader, int64(segSize), int(dataBlocks), int(parityBlocks), size, onProgress,
+		c.hashMaxBufferedSegments)
+}
+
+// ComputeHashRootsFromFile reports the size of the file as the total bytes of the progress
+func (c *client) ComputeHashRootsFromFile(filePath string, onProgress types.HashProgressFunc) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, err
+	}
+	return c.ComputeHashRootsWithProgress(f, info.Size(), onProgress)
+}
+
+// segmentHashes are the hash of a segment and the hashes of its erasure-coded pieces
+type segmentHashes struct {
+	segment []byte
+	pieces  [][]byte
+}
+
+// computeHashRootsParallel computes the same hash roots as hashlib.ComputeIntegrityHash, the segments are read fully
+// so that the short reads of the network streams do not split the segments. The segments are read into at most
+// maxBuffers buffers which are reused once the segments are hashed, two buffers per CPU are used if it is not positive.
+func computeHashRootsParallel(reader io.Reader, segSize int64, dataBlocks, parityBlocks int, size int64,
+	onProgress types.HashProgressFunc, maxBuffers int,
+) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	type job struct {
+		id   int
+		data []byte
+	}
+	workers := runtime.NumCPU()
+	if maxBuffers <= 0 {
+		maxBuffers = 2 * workers
+	} else if maxBuffers < workers {
+		workers = maxBuffers
+	}
+	jobs := make(chan job, maxBuffers)
+	// the buffers are allocated on first use, so the small payloads do not allocate all of them
+	buffers := make(chan []byte, maxBuffers)
+	for i := 0; i < maxBuffers; i++ {
+		buffers <- nil
+	}
+
+	var (
+		wg            sync.WaitGroup
+		mutex         sync.Mutex
+		hashes        []*segmentHashes
+		hashErr       error
+		progress      = types.HashProgress{TotalBytes: size}
+		progressMutex sync.Mutex
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				segmentHash := hashlib.GenerateChecksum(j.data)
+				pieces, err := redundancy.EncodeRawSegment(j.data, dataBlocks, parityBlocks)
+				var pieceHashes [][]byte
+				if err == nil {
+					pieceHashes = make([][]byte, len(pieces))
+					for k, piece := range pieces {
+						pieceHashes[k] = hashlib.GenerateChecksum(piece)
+					}
+				}
+				// the pieces may share the buffer, so it is given back after they are hashed
+				dataLen := len(j.data)
+				buffers <- j.data[:cap(j.data)]
+
+				mutex.Lock()
+				if err != nil {
+					if hashErr == nil {
+						hashErr = err
+					}
+					mutex.Unlock()
+					continue
+				}
+				for len(hashes) <= j.id {
+					hashes = append(hashes, nil)
+				}
+				hashes[j.id] = &segmentHashes{segment: segmentHash, pieces: pieceHashes}
+				mutex.Unlock()
+
+				if onProgress != nil {
+					progressMutex.Lock()
+					progress.HashedBytes += int64(dataLen)
+					progress.HashedSegments++
+					onProgress(progress)
+					progressMutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	var (
+		contentLen int64
+		readErr    error
+	)
+	for id := 0; ; id++ {
+		seg := <-buffers
+		if seg == nil {
+			seg = make([]byte, segSize)
+		}
+		n, err := io.ReadFull(reader, seg)
+		if n > 0 {
+			contentLen += int64(n)
+			jobs <- job{id: id, data: seg[:n]}
+		} else {
+			buffers <- seg
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if readErr != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, readErr
+	}
+	if hashErr != nil {
+		return nil, 0, storageTypes.REDUNDANCY_EC_TYPE, hashErr
+	}
+
+	segmentList := make([][]byte, len(hashes))
+	pieceLists := make([][][]byte, dataBlocks+parityBlocks)
+	for i, h := range hashes {
+		segmentList[i] = h.segment
+		for k, pieceHash := range h.pieces {
+			pieceLists[k] = append(pieceLists[k], pieceHash)
+		}
+	}
+	roots := make([][]byte, 0, len(pieceLists)+1)
+	roots = append(roots, hashlib.GenerateIntegrityHash(segmentList))
+	for _, pieceList := range pieceLists {
+		roots = append(roots, hashlib.GenerateIntegrityHash(pieceList))
+	}
+	return roots, contentLen, storageTypes.REDUNDANCY_EC_TYPE, nil
+}
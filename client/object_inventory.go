@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// WriteInventory lists the objects from the chain state at the height, so the inventory is consistent even if the
+// bucket is changed during the listing, and writes a CSV record for each object
+func (c *client) WriteInventory(ctx context.Context, bucketName string, w io.Writer, opts types.InventoryOptions) (*types.InventoryReport, error) {
+	height := opts.Height
+	if height == 0 {
+		var err error
+		if height, err = c.GetLatestBlockHeight(ctx); err != nil {
+			return nil, err
+		}
+	}
+	report := &types.InventoryReport{BucketName: bucketName, Height: height}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(types.InventoryCSVHeader); err != nil {
+		return nil, err
+	}
+	iter := c.ListObjectsAtHeight(ctx, bucketName, height)
+	defer iter.Close()
+	for iter.Next() {
+		objectInfo := iter.Value()
+		if !strings.HasPrefix(objectInfo.ObjectName, opts.Prefix) {
+			continue
+		}
+		if err := csvWriter.Write(types.InventoryRecord(objectInfo)); err != nil {
+			return nil, err
+		}
+		report.ObjectCount++
+		report.TotalSize += objectInfo.PayloadSize
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list the objects of bucket %s at height %d: %w", bucketName, height, err)
+	}
+	csvWriter.Flush()
+	return report, csvWriter.Error()
+}
+
+// ExportInventory writes the inventory to a temporary file first, which is renamed to the local file or uploaded as
+// the object once the listing is completed, so a partial inventory is never exposed
+func (c *client) ExportInventory(ctx context.Context, bucketName string, dest types.InventoryDestination, opts types.InventoryOptions) (*types.InventoryReport, error) {
+	toFile := dest.FilePath != ""
+	toObject := dest.BucketName != "" || dest.ObjectName != ""
+	if toFile == toObject {
+		return nil, fmt.Errorf("%w: either the file path or the object of the inventory should be set", types.ErrorInvalidOption)
+	}
+	if toObject && (dest.BucketName == "" || dest.ObjectName == "") {
+		return nil, fmt.Errorf("%w: both the bucket name and the object name of the inventory should be set", types.ErrorInvalidOption)
+	}
+
+	// the temporary file is created next to the local file to be renamed atomically
+	tempDir := ""
+	if toFile {
+		tempDir = filepath.Dir(dest.FilePath)
+	}
+	file, err := os.CreateTemp(tempDir, ".inventory-*.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	report, err := c.WriteInventory(ctx, bucketName, file, opts)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if toFile {
+		return report, os.Rename(file.Name(), dest.FilePath)
+	}
+	putOpts := dest.PutOpts
+	if putOpts.CreateOpts.ContentType == "" {
+		putOpts.CreateOpts.ContentType = "text/csv"
+	}
+	if report.TxnHash, err = c.PutObjectFromFile(ctx, dest.BucketName, dest.ObjectName, file.Name(), putOpts); err != nil {
+		return nil, fmt.Errorf("failed to upload the inventory to %s/%s: %w", dest.BucketName, dest.ObjectName, err)
+	}
+	return report, nil
+}
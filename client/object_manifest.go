@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ExportChecksums recomputes the hash tree from the stored payload with the redundancy params in effect when the
+// object was created, it fails with ErrorChecksumMismatch if the roots differ from the on-chain checksums
+func (c *client) ExportChecksums(ctx context.Context, bucketName, objectName string) (*types.ChecksumManifest, error) {
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	objectInfo := objectDetail.ObjectInfo
+	if objectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+		return nil, fmt.Errorf("the object %s is not sealed, status: %s", objectName, objectInfo.ObjectStatus)
+	}
+
+	paramsResp, err := c.chainClient.StorageQueryClient.QueryParamsByTimestamp(ctx, &storageTypes.QueryParamsByTimestampRequest{Timestamp: objectInfo.CreateAt})
+	if err != nil {
+		return nil, err
+	}
+	versionedParams := paramsResp.Params.VersionedParams
+	segmentSize := versionedParams.GetMaxSegmentSize()
+	dataChunkNum, parityChunkNum := versionedParams.GetRedundantDataChunkNum(), versionedParams.GetRedundantParityChunkNum()
+	if segmentSize == 0 {
+		return nil, errors.New("the segment size of the object is unknown")
+	}
+
+	body, _, err := c.GetObject(ctx, bucketName, objectName, types.GetObjectOptions{DisableDecompression: true})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	manifest := &types.ChecksumManifest{
+		Version:        types.ChecksumManifestVersion,
+		HashAlgorithm:  "sha256",
+		BucketName:     bucketName,
+		ObjectName:     objectName,
+		ObjectID:       objectInfo.Id.String(),
+		PayloadSize:    objectInfo.PayloadSize,
+		RedundancyType: objectInfo.RedundancyType.String(),
+		SegmentSize:    segmentSize,
+		DataChunkNum:   dataChunkNum,
+		ParityChunkNum: parityChunkNum,
+		SegmentHashes:  make([]string, 0),
+		PieceHashes:    make([][]string, dataChunkNum+parityChunkNum),
+	}
+	for i := range manifest.PieceHashes {
+		manifest.PieceHashes[i] = make([]string, 0)
+	}
+	for _, checksum := range objectInfo.Checksums {
+		manifest.Checksums = append(manifest.Checksums, hex.EncodeToString(checksum))
+	}
+
+	var size uint64
+	segment := make([]byte, segmentSize)
+	for {
+		n, err := io.ReadFull(body, segment)
+		if n > 0 {
+			size += uint64(n)
+			manifest.SegmentHashes = append(manifest.SegmentHashes, hex.EncodeToString(hashlib.GenerateChecksum(segment[:n])))
+			pieces, encodeErr := redundancy.EncodeRawSegment(segment[:n], int(dataChunkNum), int(parityChunkNum))
+			if encodeErr != nil {
+				return nil, encodeErr
+			}
+			for i, piece := range pieces {
+				manifest.PieceHashes[i] = append(manifest.PieceHashes[i], hex.EncodeToString(hashlib.GenerateChecksum(piece)))
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if size != objectInfo.PayloadSize {
+		return nil, fmt.Errorf("%w: downloaded %d bytes of object %s, expected %d", types.ErrorChecksumMismatch, size, objectName, objectInfo.PayloadSize)
+	}
+	if err = manifest.Verify(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// PutObjectOverwrite spools the payload to a temp file, so that it can be uploaded more than once, then replaces the
+// object in the order of the strategy
+func (c *client) PutObjectOverwrite(ctx context.Context, bucketName, objectName string, reader io.Reader,
+	opts types.PutObjectOverwriteOptions,
+) (string, error) {
+	if reader == nil {
+		return "", errors.New("the payload reader is nil")
+	}
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return "", err
+	}
+	if err := utils.VerifyObjectName(objectName); err != nil {
+		return "", err
+	}
+
+	// the content type is detected from the object name rather than the name of the spooled file
+	if opts.PutOpts.CreateOpts.ContentType == "" {
+		if opts.PutOpts.CreateOpts.DisableContentTypeSniffing {
+			opts.PutOpts.CreateOpts.ContentType = types.ContentDefault
+		} else {
+			var err error
+			if opts.PutOpts.CreateOpts.ContentType, reader, err = sniffContentType(objectName, reader); err != nil {
+				return "", err
+			}
+		}
+	}
+	spoolFile, err := os.CreateTemp(opts.SpoolDir, "gnfd-overwrite-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(spoolFile.Name())
+	_, err = io.Copy(spoolFile, reader)
+	if closeErr := spoolFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	stagedName := ""
+	if opts.Strategy == types.OverwriteStaged {
+		stagedName = fmt.Sprintf("%s%s%d", objectName, types.OverwriteStagedSuffix, c.now().UnixNano())
+		stagedOpts := opts.PutOpts
+		stagedOpts.DisableWaitSeal = false
+		if _, err = c.PutObjectFromFile(ctx, bucketName, stagedName, spoolFile.Name(), stagedOpts); err != nil {
+			return "", fmt.Errorf("failed to upload the staged object %s: %w", stagedName, err)
+		}
+	}
+
+	if err = c.removeObjectForOverwrite(ctx, bucketName, objectName, opts.DeleteOpts); err != nil {
+		return "", err
+	}
+
+	txnHash, err := c.PutObjectFromFile(ctx, bucketName, objectName, spoolFile.Name(), opts.PutOpts)
+	if err != nil {
+		if stagedName != "" {
+			return txnHash, fmt.Errorf("failed to upload the object %s, the payload is kept in the staged object %s: %w", objectName, stagedName, err)
+		}
+		return txnHash, err
+	}
+
+	if stagedName != "" {
+		if _, err = c.DeleteObject(ctx, bucketName, stagedName, opts.DeleteOpts); err != nil {
+			return txnHash, fmt.Errorf("the object %s is overwritten but failed to delete the staged object %s: %w", objectName, stagedName, err)
+		}
+	}
+	return txnHash, nil
+}
+
+// removeObjectForOverwrite deletes the object, or cancels it if it is not sealed, and waits for the tx to be
+// committed so that the object can be created again. It does nothing if the object does not exist.
+func (c *client) removeObjectForOverwrite(ctx context.Context, bucketName, objectName string, opts types.DeleteObjectOption) error {
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+
+	var txnHash string
+	if objectDetail.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
+		if err = c.checkDeleteProtection(bucketName+"/"+objectName, opts.Force); err != nil {
+			return err
+		}
+		txnHash, err = c.CancelCreateObject(ctx, bucketName, objectName, types.CancelCreateOption{TxOpts: opts.TxOpts})
+	} else {
+		txnHash, err = c.DeleteObject(ctx, bucketName, objectName, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove the existing object %s: %w", objectName, err)
+	}
+	ctxTimeout, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	txResult, err := c.WaitForTx(ctxTimeout, txnHash)
+	if err != nil {
+		return err
+	}
+	if txResult.TxResult.Code != 0 {
+		return fmt.Errorf("the tx %s to remove the existing object %s failed, code: %d, log: %s", txnHash, objectName,
+			txResult.TxResult.Code, txResult.TxResult.Log)
+	}
+	return nil
+}
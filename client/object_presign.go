@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// PresignPutObject signs the upload by the default account with the expiry of the options rather than the one of the
+// client. The payload is not signed, so the browser can upload any payload, which is checked by the SP against the
+// checksums of the object created on chain.
+func (c *client) PresignPutObject(ctx context.Context, bucketName, objectName string, opts types.PresignPutObjectOptions) (*types.PresignedUpload, error) {
+	if c.loadDefaultAccount() == nil {
+		return nil, types.ErrorDefaultAccountNotExist
+	}
+	expiry := opts.Expiry
+	if expiry == 0 {
+		expiry = types.DefaultPresignExpiry
+	}
+	if expiry < 0 || expiry > httplib.MaxExpiryAgeInSec*time.Second {
+		return nil, fmt.Errorf("%w: Expiry %s is out of the range (0, %ds]", types.ErrorInvalidOption, expiry, httplib.MaxExpiryAgeInSec)
+	}
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = types.ContentDefault
+	}
+
+	endpoint, err := c.getSPUrlByBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	reqMeta := requestMeta{
+		bucketName:    bucketName,
+		objectName:    objectName,
+		contentSHA256: types.EmptyStringSHA256,
+		contentType:   contentType,
+	}
+	req, err := c.newRequest(ctx, http.MethodPut, reqMeta, nil, opts.TxnHash, false, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	// the request is signed again with the expiry of the upload
+	expiresAt := c.now().UTC().Add(expiry).Truncate(time.Second)
+	req.Header.Set(httplib.HTTPHeaderExpiryTimestamp, expiresAt.Format(types.Iso8601DateFormatSecond))
+	if err = c.signRequest(req); err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		// the browsers do not allow setting the user agent, and the request id should be unique per request
+		if key == types.HTTPHeaderUserAgent || key == types.HTTPHeaderRequestID {
+			continue
+		}
+		headers[key] = req.Header.Get(key)
+	}
+	return &types.PresignedUpload{
+		Method:    http.MethodPut,
+		URL:       req.URL.String(),
+		Headers:   headers,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// VerifyPresignedRequest verifies the GNFD1-ECDSA authorization of the request is signed by the signer and has not
+// expired, e.g. by a gateway in front of the SP which only admits the uploads signed by its backend. The host of the
+// request must be the host of the SP which it was signed for.
+func (c *client) VerifyPresignedRequest(req *http.Request, signerAddr string) error {
+	signer, err := sdk.AccAddressFromHexUnsafe(signerAddr)
+	if err != nil {
+		return err
+	}
+	auth := req.Header.Get(types.HTTPHeaderAuthorization)
+	if !strings.HasPrefix(auth, httplib.Gnfd1Ecdsa) {
+		return fmt.Errorf("%w: the request is not authorized by %s", types.ErrorInvalidAuthorization, httplib.Gnfd1Ecdsa)
+	}
+	sigIndex := strings.Index(auth, "Signature=")
+	if sigIndex < 0 {
+		return fmt.Errorf("%w: the signature is missing", types.ErrorInvalidAuthorization)
+	}
+	signature, err := hex.DecodeString(strings.TrimSpace(auth[sigIndex+len("Signature="):]))
+	if err != nil {
+		return fmt.Errorf("%w: the signature is not HEX-encoded: %v", types.ErrorInvalidAuthorization, err)
+	}
+
+	expiresAt, err := time.Parse(types.Iso8601DateFormatSecond, req.Header.Get(httplib.HTTPHeaderExpiryTimestamp))
+	if err != nil {
+		return fmt.Errorf("%w: invalid expiry timestamp: %v", types.ErrorInvalidAuthorization, err)
+	}
+	if !c.now().Before(expiresAt) {
+		return fmt.Errorf("%w: the authorization expired at %s", types.ErrorInvalidAuthorization, expiresAt)
+	}
+
+	// the canonical request rewrites the query of the url, so a copy is signed
+	unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req.Clone(req.Context()))
+	if err = gnfdTypes.VerifySignature(signer, unsignedMsg, signature); err != nil {
+		return fmt.Errorf("%w: %v", types.ErrorInvalidAuthorization, err)
+	}
+	return nil
+}
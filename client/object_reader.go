@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ObjectReader reads the object payload with range requests, the recently read blocks are cached.
+// It implements io.Reader, io.ReaderAt, io.Seeker and io.Closer, and it is safe for concurrent ReadAt calls.
+type ObjectReader struct {
+	ctx        context.Context
+	client     *client
+	bucketName string
+	objectName string
+	size       int64
+
+	mu     sync.Mutex
+	offset int64
+	closed bool
+	blocks map[int64][]byte
+	// the indexes of the cached blocks from the least recently used to the most recently used
+	lru []int64
+}
+
+// NewObjectReader returns the reader of the object, the object must be sealed to be read
+func (c *client) NewObjectReader(ctx context.Context, bucketName, objectName string) (*ObjectReader, error) {
+	objectDetail, err := c.HeadObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectReader{
+		ctx:        ctx,
+		client:     c,
+		bucketName: bucketName,
+		objectName: objectName,
+		size:       int64(objectDetail.ObjectInfo.GetPayloadSize()),
+		blocks:     make(map[int64][]byte),
+	}, nil
+}
+
+// Size returns the payload size of the object
+func (r *ObjectReader) Size() int64 {
+	return r.size
+}
+
+// Read reads from the current offset and advances it
+func (r *ObjectReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	offset := r.offset
+	r.mu.Unlock()
+
+	n, err := r.ReadAt(p, offset)
+
+	r.mu.Lock()
+	r.offset = offset + int64(n)
+	r.mu.Unlock()
+	return n, err
+}
+
+// ReadAt reads len(p) bytes from the offset off, it returns io.EOF if fewer bytes are read because of the end of the object
+func (r *ObjectReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	n := 0
+	for n < len(p) && off < r.size {
+		blockIdx := off / types.ObjectReaderBlockSize
+		block, err := r.getBlock(blockIdx)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], block[off-blockIdx*types.ObjectReaderBlockSize:])
+		n += copied
+		off += int64(copied)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek sets the offset of the next Read
+func (r *ObjectReader) Seek(offset int64, whence int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+// Close drops the cached blocks, the reader can not be read after closed
+func (r *ObjectReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	r.blocks = nil
+	r.lru = nil
+	return nil
+}
+
+// getBlock returns the cached block of the index or requests it from the SP
+func (r *ObjectReader) getBlock(blockIdx int64) ([]byte, error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil, errors.New("object reader is closed")
+	}
+	if block, ok := r.blocks[blockIdx]; ok {
+		r.touch(blockIdx)
+		r.mu.Unlock()
+		return block, nil
+	}
+	r.mu.Unlock()
+
+	start := blockIdx * types.ObjectReaderBlockSize
+	end := start + types.ObjectReaderBlockSize - 1
+	if end >= r.size {
+		end = r.size - 1
+	}
+	var opts types.GetObjectOptions
+	if err := opts.SetRange(start, end); err != nil {
+		return nil, err
+	}
+	body, _, err := r.client.GetObject(r.ctx, r.bucketName, r.objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	block := make([]byte, end-start+1)
+	if _, err = io.ReadFull(body, block); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("object reader is closed")
+	}
+	if _, ok := r.blocks[blockIdx]; !ok {
+		if len(r.lru) >= types.ObjectReaderCacheBlocks {
+			delete(r.blocks, r.lru[0])
+			r.lru = r.lru[1:]
+		}
+		r.blocks[blockIdx] = block
+		r.lru = append(r.lru, blockIdx)
+	}
+	return block, nil
+}
+
+// touch marks the block as the most recently used one, the caller must hold the lock
+func (r *ObjectReader) touch(blockIdx int64) {
+	for i, idx := range r.lru {
+		if idx == blockIdx {
+			r.lru = append(append(r.lru[:i:i], r.lru[i+1:]...), blockIdx)
+			return
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// newRetryableBody returns the payload reader and the function rewinding it to the beginning of the payload, which is
+// nil if the payload cannot be re-sent. The payload which is not an io.Seeker is buffered in memory if it is small
+// enough and disableBuffering is false.
+func newRetryableBody(reader io.Reader, size int64, disableBuffering bool) (io.Reader, func() error, error) {
+	if seeker, ok := reader.(io.Seeker); ok {
+		if start, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			return reader, func() error {
+				_, err := seeker.Seek(start, io.SeekStart)
+				return err
+			}, nil
+		}
+	}
+	if disableBuffering || size > types.UploadRetryBufferSize {
+		return reader, nil, nil
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return nil, nil, fmt.Errorf("failed to read the payload of %d bytes: %w", size, err)
+	}
+	body := bytes.NewReader(buf)
+	return body, func() error {
+		_, err := body.Seek(0, io.SeekStart)
+		return err
+	}, nil
+}
+
+// sendUploadReq sends the upload request, and re-sends it with the payload rewound by rewind if the request fails with
+// a network error or a server error, at most Option.UploadMaxTryTime times in total
+func (c *client) sendUploadReq(ctx context.Context, reqMeta requestMeta, sendOpt *sendOptions, endpoint *url.URL, rewind func() error) error {
+	maxTryTime := c.uploadMaxTryTime
+	if maxTryTime <= 0 {
+		maxTryTime = types.MaxUploadTryTime
+	}
+	backoffDelay := c.uploadBackOffDelay
+	if backoffDelay <= 0 {
+		backoffDelay = types.UploadBackOffDelay
+	}
+	for retry := 1; ; retry++ {
+		_, err := c.sendReq(ctx, reqMeta, sendOpt, endpoint)
+		if err == nil || !isRetryableUploadErr(ctx, err) {
+			return err
+		}
+		if rewind == nil {
+			log.Warn().Msgf("the upload of object %s is not re-sent as the payload is not an io.Seeker", reqMeta.objectName)
+			return err
+		}
+		if retry >= maxTryTime {
+			return err
+		}
+		if rewindErr := rewind(); rewindErr != nil {
+			log.Error().Msgf("failed to rewind the payload of object %s: %v", reqMeta.objectName, rewindErr)
+			return err
+		}
+
+		log.Warn().Msgf("failed to upload object %s, retry %d after %s: %v", reqMeta.objectName, retry, backoffDelay, err)
+		select {
+		case <-time.After(backoffDelay):
+		case <-ctx.Done():
+			return err
+		}
+		backoffDelay *= 2
+	}
+}
+
+// isRetryableUploadErr reports whether the upload error is a network error or a server error
+func isRetryableUploadErr(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var errResp types.ErrResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
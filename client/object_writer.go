@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ObjectWriter writes the object payload through UploadStream, the written data is spooled to local segment files
+// while the checksums are computed, and the object is created and uploaded when the writer is closed.
+// It implements io.WriteCloser, and it is not safe for concurrent Write calls.
+type ObjectWriter struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+
+	once    sync.Once
+	txnHash string
+	err     error
+}
+
+// NewObjectWriter returns the writer of the object, the upload runs in the background until the writer is closed
+func (c *client) NewObjectWriter(ctx context.Context, bucketName, objectName string, opts types.SpoolOptions) (*ObjectWriter, error) {
+	if err := utils.VerifyBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := utils.VerifyObjectName(objectName); err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	w := &ObjectWriter{pw: pw, done: make(chan struct{})}
+	go func() {
+		defer close(w.done)
+		w.txnHash, w.err = c.UploadStream(ctx, bucketName, objectName, pr, opts)
+		if w.err != nil {
+			// unblock and fail the pending and later writes
+			pr.CloseWithError(w.err)
+		}
+	}()
+	return w, nil
+}
+
+// Write spools p, it fails with the error of the upload if the upload has failed
+func (w *ObjectWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close ends the payload and waits for the object to be created and uploaded, it returns the error of the upload
+func (w *ObjectWriter) Close() error {
+	return w.CloseWithError(nil)
+}
+
+// CloseWithError aborts the upload with err if it is not nil, the object is not created if the payload has not ended.
+// It waits for the upload to stop and returns the error of the upload.
+func (w *ObjectWriter) CloseWithError(err error) error {
+	w.once.Do(func() {
+		if err != nil {
+			w.pw.CloseWithError(err)
+		} else {
+			w.pw.Close()
+		}
+	})
+	<-w.done
+	return w.err
+}
+
+// TxnHash returns the hash of the createObject txn, it is empty until the writer is closed successfully
+func (w *ObjectWriter) TxnHash() string {
+	select {
+	case <-w.done:
+		return w.txnHash
+	default:
+		return ""
+	}
+}
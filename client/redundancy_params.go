@@ -0,0 +1,49 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// resolveRedundancyParams returns the data shards, the parity shards and the segment size of the chain overridden by
+// the client config and then by the override of the call. The overrides must agree with the chain params if they can
+// be queried, so the payloads are never hashed with the params which the SPs reject.
+func (c *client) resolveRedundancyParams(override *types.ComputeHashOptions) (uint32, uint32, uint64, error) {
+	var opts types.ComputeHashOptions
+	if c.redundancyParams != nil {
+		opts = *c.redundancyParams
+	}
+	if override != nil {
+		if override.SegmentSize != 0 {
+			opts.SegmentSize = override.SegmentSize
+		}
+		if override.DataShards != 0 {
+			opts.DataShards = override.DataShards
+		}
+		if override.ParityShards != 0 {
+			opts.ParityShards = override.ParityShards
+		}
+	}
+
+	params, err := c.GetParams()
+	if err != nil {
+		if opts.SegmentSize == 0 || opts.DataShards == 0 || opts.ParityShards == 0 {
+			return 0, 0, 0, err
+		}
+		return opts.DataShards, opts.ParityShards, opts.SegmentSize, nil
+	}
+	versionedParams := params.VersionedParams
+	dataShards, parityShards, segSize := versionedParams.GetRedundantDataChunkNum(),
+		versionedParams.GetRedundantParityChunkNum(), versionedParams.GetMaxSegmentSize()
+	if opts.DataShards != 0 && opts.DataShards != dataShards {
+		return 0, 0, 0, fmt.Errorf("%w: DataShards %d, %d on chain", types.ErrorRedundancyMismatch, opts.DataShards, dataShards)
+	}
+	if opts.ParityShards != 0 && opts.ParityShards != parityShards {
+		return 0, 0, 0, fmt.Errorf("%w: ParityShards %d, %d on chain", types.ErrorRedundancyMismatch, opts.ParityShards, parityShards)
+	}
+	if opts.SegmentSize != 0 && opts.SegmentSize != segSize {
+		return 0, 0, 0, fmt.Errorf("%w: SegmentSize %d, %d on chain", types.ErrorRedundancyMismatch, opts.SegmentSize, segSize)
+	}
+	return dataShards, parityShards, segSize, nil
+}
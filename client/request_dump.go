@@ -0,0 +1,146 @@
+package client
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// redactedValue replaces the secrets in the request dump
+const redactedValue = "<redacted>"
+
+// EnableRequestDump enables dumping the signed requests sent to SP to the output, os.Stdout is used if output is nil
+func (c *client) EnableRequestDump(output io.Writer, opts types.RequestDumpOptions) {
+	if output == nil {
+		output = os.Stdout
+	}
+	c.requestDumpMutex.Lock()
+	defer c.requestDumpMutex.Unlock()
+	c.requestDumpOpts = opts
+	c.requestDumpOutput = output
+}
+
+// requestDumpConfig returns the output and the options of the request dump, the output is nil if it is disabled
+func (c *client) requestDumpConfig() (io.Writer, types.RequestDumpOptions) {
+	c.requestDumpMutex.Lock()
+	defer c.requestDumpMutex.Unlock()
+	return c.requestDumpOutput, c.requestDumpOpts
+}
+
+// dumpSignedRequest writes the signed request, the canonical request and the curl command of it, and the status and
+// headers of the response or the error of sending the request to the output. The payload and the response body are
+// not dumped.
+func (c *client) dumpSignedRequest(output io.Writer, opts types.RequestDumpOptions, req *http.Request, resp *http.Response, sendErr error) {
+	failed := sendErr != nil || resp.StatusCode/100 != 2
+	if opts.OnlyError && !failed {
+		return
+	}
+
+	// the canonical request is generated from a clone, as generating it normalizes the query of the request
+	canonicalRequest := httplib.GetCanonicalRequest(req.Clone(req.Context()))
+	msgToSign := httplib.GetMsgToSignInGNFD1Auth(req.Clone(req.Context()))
+	headers := redactHeaders(req.Header, opts.ShowSignature)
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "---------SIGNED REQUEST---------")
+	fmt.Fprintf(&b, "%s %s\n", req.Method, req.URL.String())
+	if req.Host != "" && req.Host != req.URL.Host {
+		fmt.Fprintf(&b, "Host: %s\n", req.Host)
+	}
+	for _, key := range sortedHeaderKeys(headers) {
+		for _, value := range headers[key] {
+			fmt.Fprintf(&b, "%s: %s\n", key, value)
+		}
+	}
+	fmt.Fprintln(&b, "---------CANONICAL REQUEST---------")
+	fmt.Fprintln(&b, canonicalRequest)
+	fmt.Fprintf(&b, "msg to sign: %s\n", hex.EncodeToString(msgToSign))
+	fmt.Fprintln(&b, "---------CURL---------")
+	fmt.Fprintln(&b, curlCommand(req, headers))
+	fmt.Fprintln(&b, "---------RESPONSE---------")
+	if sendErr != nil {
+		fmt.Fprintf(&b, "error: %v\n", sendErr)
+	} else {
+		fmt.Fprintln(&b, resp.Status)
+		for _, key := range sortedHeaderKeys(resp.Header) {
+			for _, value := range resp.Header[key] {
+				fmt.Fprintf(&b, "%s: %s\n", key, value)
+			}
+		}
+	}
+	fmt.Fprintln(&b, "---------END---------")
+
+	// the dumps of the concurrent requests are not interleaved
+	c.requestDumpMutex.Lock()
+	defer c.requestDumpMutex.Unlock()
+	if _, err := io.WriteString(output, b.String()); err != nil {
+		log.Error().Msg("dump signed request err:" + err.Error())
+	}
+}
+
+// redactHeaders returns a copy of the headers with the signature in the Authorization header redacted unless
+// showSignature is set
+func redactHeaders(header http.Header, showSignature bool) http.Header {
+	headers := header.Clone()
+	if showSignature {
+		return headers
+	}
+	for _, key := range []string{types.HTTPHeaderAuthorization, "Cookie", "Proxy-Authorization"} {
+		values := headers.Values(key)
+		for i, value := range values {
+			values[i] = redactSignature(value)
+		}
+	}
+	return headers
+}
+
+// redactSignature keeps the auth algorithm of the Authorization value, e.g. "GNFD1-ECDSA, Signature=<redacted>"
+func redactSignature(value string) string {
+	if i := strings.Index(value, "Signature="); i >= 0 {
+		return value[:i] + "Signature=" + redactedValue
+	}
+	return redactedValue
+}
+
+// curlCommand returns the curl command sending the same request, the payload is read from a file named payload
+func curlCommand(req *http.Request, headers http.Header) string {
+	parts := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+	if req.Host != "" && req.Host != req.URL.Host {
+		parts = append(parts, "-H", shellQuote("Host: "+req.Host))
+	}
+	for _, key := range sortedHeaderKeys(headers) {
+		// curl sets the length of the payload itself
+		if key == "Content-Length" {
+			continue
+		}
+		for _, value := range headers[key] {
+			parts = append(parts, "-H", shellQuote(key+": "+value))
+		}
+	}
+	if req.ContentLength > 0 {
+		parts = append(parts, "--data-binary", "@payload")
+	}
+	return strings.Join(parts, " ")
+}
+
+func sortedHeaderKeys(header http.Header) []string {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
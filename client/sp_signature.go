@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	spTypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	vgTypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// VerifySPSignature queries the approval address of the SP from chain rather than the cached SP list, so the approval
+// address updated recently is honoured
+func (c *client) VerifySPSignature(ctx context.Context, spAddr string, signBytes []byte, signature []byte) error {
+	acc, err := sdk.AccAddressFromHexUnsafe(spAddr)
+	if err != nil {
+		return err
+	}
+	sp, err := c.GetStorageProviderInfo(ctx, acc)
+	if err != nil {
+		return err
+	}
+	return verifyApprovalSignature(sp, signBytes, signature)
+}
+
+// VerifyCreateBucketApproval verifies the approval signed over the msg without the signature, as the chain does
+func (c *client) VerifyCreateBucketApproval(ctx context.Context, msg *storageTypes.MsgCreateBucket) error {
+	if msg.PrimarySpApproval == nil {
+		return fmt.Errorf("%w: the createBucket msg of bucket %s is not approved", types.ErrorInvalidSPSignature, msg.BucketName)
+	}
+	return c.VerifySPSignature(ctx, msg.PrimarySpAddress, msg.GetApprovalBytes(), msg.PrimarySpApproval.Sig)
+}
+
+// VerifyCreateObjectApproval verifies the approval signed over the msg without the signature, as the chain does
+func (c *client) VerifyCreateObjectApproval(ctx context.Context, msg *storageTypes.MsgCreateObject) error {
+	if msg.PrimarySpApproval == nil {
+		return fmt.Errorf("%w: the createObject msg of object %s is not approved", types.ErrorInvalidSPSignature, msg.ObjectName)
+	}
+	sp, err := c.getPrimarySPOnChain(ctx, msg.BucketName)
+	if err != nil {
+		return err
+	}
+	return verifyApprovalSignature(sp, msg.GetApprovalBytes(), msg.PrimarySpApproval.Sig)
+}
+
+// VerifySealObjectSignature verifies the seal the same way as the chain, except that the seal is not checked against
+// the status of the object
+func (c *client) VerifySealObjectSignature(ctx context.Context, msg *storageTypes.MsgSealObject) error {
+	sp, err := c.getPrimarySPOnChain(ctx, msg.BucketName)
+	if err != nil {
+		return err
+	}
+	if msg.Operator != sp.SealAddress {
+		return fmt.Errorf("%w: the object %s is sealed by %s rather than the seal address %s of sp %d",
+			types.ErrorInvalidSPSignature, msg.ObjectName, msg.Operator, sp.SealAddress, sp.Id)
+	}
+	objectDetail, err := c.HeadObject(ctx, msg.BucketName, msg.ObjectName)
+	if err != nil {
+		return err
+	}
+	gvgResp, err := c.chainClient.GlobalVirtualGroup(ctx, &vgTypes.QueryGlobalVirtualGroupRequest{GlobalVirtualGroupId: msg.GlobalVirtualGroupId})
+	if err != nil {
+		return err
+	}
+	blsKeys := make([]bls.PublicKey, 0, len(gvgResp.GlobalVirtualGroup.SecondarySpIds))
+	for _, spID := range gvgResp.GlobalVirtualGroup.SecondarySpIds {
+		spResp, err := c.chainClient.StorageProvider(ctx, &spTypes.QueryStorageProviderRequest{Id: spID})
+		if err != nil {
+			return err
+		}
+		blsKey, err := bls.PublicKeyFromBytes(spResp.StorageProvider.BlsKey)
+		if err != nil {
+			return fmt.Errorf("invalid bls key of sp %d: %w", spID, err)
+		}
+		blsKeys = append(blsKeys, blsKey)
+	}
+	chainID, err := c.chainClient.GetChainId()
+	if err != nil {
+		return err
+	}
+	signHash := storageTypes.NewSecondarySpSealObjectSignDoc(chainID, msg.GlobalVirtualGroupId, objectDetail.ObjectInfo.Id,
+		storageTypes.GenerateHash(objectDetail.ObjectInfo.Checksums)).GetBlsSignHash()
+	if err = gnfdTypes.VerifyBlsAggSignature(blsKeys, signHash, msg.SecondarySpBlsAggSignatures); err != nil {
+		return fmt.Errorf("%w: the seal of object %s: %v", types.ErrorInvalidSPSignature, msg.ObjectName, err)
+	}
+	return nil
+}
+
+// getPrimarySPOnChain queries the primary SP of the bucket from chain
+func (c *client) getPrimarySPOnChain(ctx context.Context, bucketName string) (*spTypes.StorageProvider, error) {
+	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	family, err := c.QueryVirtualGroupFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if err != nil {
+		return nil, err
+	}
+	spResp, err := c.chainClient.StorageProvider(ctx, &spTypes.QueryStorageProviderRequest{Id: family.PrimarySpId})
+	if err != nil {
+		return nil, err
+	}
+	return spResp.StorageProvider, nil
+}
+
+// verifyApprovalSignature verifies the signature of the keccak256 hash of the sign bytes by the approval address
+func verifyApprovalSignature(sp *spTypes.StorageProvider, signBytes []byte, signature []byte) error {
+	approvalAddr, err := sdk.AccAddressFromHexUnsafe(sp.ApprovalAddress)
+	if err != nil {
+		return err
+	}
+	if err = gnfdTypes.VerifySignature(approvalAddr, sdk.Keccak256(signBytes), signature); err != nil {
+		return fmt.Errorf("%w: not signed by the approval address %s of sp %d: %v", types.ErrorInvalidSPSignature, sp.ApprovalAddress, sp.Id, err)
+	}
+	return nil
+}
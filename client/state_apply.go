@@ -0,0 +1,671 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// PlanAccountState compares the desired state with the chain and returns the changes to reach it. The groups are
+// planned before the buckets, so that the policies granted to the groups to be created are known.
+func (c *client) PlanAccountState(ctx context.Context, desired *types.AccountState, opts types.PlanOptions) (*types.ApplyPlan, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	owner := account.GetAddress().String()
+	if desired == nil {
+		return nil, errors.New("the desired account state is empty")
+	}
+	for _, principalAddr := range opts.PolicyPrincipals {
+		if _, err = sdk.AccAddressFromHexUnsafe(principalAddr); err != nil {
+			return nil, fmt.Errorf("invalid policy principal %s: %w", principalAddr, err)
+		}
+	}
+
+	plan := &types.ApplyPlan{Owner: owner}
+	// groupIDs are the ids of the groups owned by the account which can be the policy principals, the id is zero
+	// if the group is to be created
+	groupIDs := make(map[string]uint64)
+	for _, group := range desired.Groups {
+		if group.GroupName == "" {
+			return nil, errors.New("the group name is empty")
+		}
+		if _, ok := groupIDs[group.GroupName]; ok {
+			return nil, fmt.Errorf("group %s is duplicated", group.GroupName)
+		}
+		change, groupID, err := c.planGroup(ctx, owner, group)
+		if err != nil {
+			return nil, err
+		}
+		groupIDs[group.GroupName] = groupID
+		if change != nil {
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	desiredBuckets := make(map[string]bool)
+	for _, bucket := range desired.Buckets {
+		if bucket.BucketName == "" {
+			return nil, errors.New("the bucket name is empty")
+		}
+		if desiredBuckets[bucket.BucketName] {
+			return nil, fmt.Errorf("bucket %s is duplicated", bucket.BucketName)
+		}
+		desiredBuckets[bucket.BucketName] = true
+		changes, err := c.planBucket(ctx, owner, bucket, groupIDs, opts)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	if !opts.Prune {
+		return plan, nil
+	}
+	buckets, err := c.ListBuckets(ctx, types.ListBucketsOptions{EndPointOptions: opts.EndPointOptions})
+	if err != nil {
+		return nil, err
+	}
+	for _, bucket := range buckets.Buckets {
+		if bucket.Removed || bucket.BucketInfo == nil || desiredBuckets[bucket.BucketInfo.BucketName] {
+			continue
+		}
+		bucketName := bucket.BucketInfo.BucketName
+		if err = c.checkDeleteProtection(bucketName, opts.Force); err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, &types.ApplyChange{
+			Action:   types.ApplyActionDelete,
+			Kind:     types.ApplyResourceBucket,
+			Resource: fmt.Sprintf("bucket %q", bucketName),
+			Bucket:   &types.BucketState{BucketName: bucketName},
+		})
+	}
+	groupIter := c.ListGroupsByOwnerIterator(ctx, types.ListGroupsByOwnerOptions{Owner: owner, EndPointOptions: opts.EndPointOptions})
+	defer groupIter.Close()
+	for groupIter.Next() {
+		group := groupIter.Value()
+		if group.Removed || group.Group == nil {
+			continue
+		}
+		if _, ok := groupIDs[group.Group.GroupName]; ok {
+			continue
+		}
+		plan.Changes = append(plan.Changes, &types.ApplyChange{
+			Action:   types.ApplyActionDelete,
+			Kind:     types.ApplyResourceGroup,
+			Resource: fmt.Sprintf("group %q", group.Group.GroupName),
+			Group:    &types.GroupState{GroupName: group.Group.GroupName},
+		})
+	}
+	if err = groupIter.Err(); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// planGroup returns the change of the group and the id of the group if it exists. The members of a group can not be
+// listed, so the members not in the desired state are kept.
+func (c *client) planGroup(ctx context.Context, owner string, group *types.GroupState) (*types.ApplyChange, uint64, error) {
+	for _, member := range group.Members {
+		if _, err := sdk.AccAddressFromHexUnsafe(member); err != nil {
+			return nil, 0, fmt.Errorf("invalid member %s of group %s: %w", member, group.GroupName, err)
+		}
+	}
+	resource := fmt.Sprintf("group %q", group.GroupName)
+	groupInfo, err := c.HeadGroup(ctx, group.GroupName, owner)
+	if err != nil {
+		if !isNotFoundErr(err) {
+			return nil, 0, err
+		}
+		var diffs []string
+		if group.Extra != "" {
+			diffs = append(diffs, fmt.Sprintf("extra: %q", group.Extra))
+		}
+		for _, member := range group.Members {
+			diffs = append(diffs, "member: + "+member)
+		}
+		return &types.ApplyChange{
+			Action:     types.ApplyActionCreate,
+			Kind:       types.ApplyResourceGroup,
+			Resource:   resource,
+			Diffs:      diffs,
+			Group:      group,
+			AddMembers: group.Members,
+		}, 0, nil
+	}
+
+	change := &types.ApplyChange{
+		Action:   types.ApplyActionUpdate,
+		Kind:     types.ApplyResourceGroup,
+		Resource: resource,
+		Group:    group,
+	}
+	if groupInfo.Extra != group.Extra {
+		change.UpdateExtra = true
+		change.Diffs = append(change.Diffs, fmt.Sprintf("extra: %q -> %q", groupInfo.Extra, group.Extra))
+	}
+	for _, member := range group.Members {
+		exist, err := c.HeadGroupMemberE(ctx, group.GroupName, owner, member)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !exist {
+			change.AddMembers = append(change.AddMembers, member)
+			change.Diffs = append(change.Diffs, "member: + "+member)
+		}
+	}
+	if len(change.Diffs) == 0 {
+		return nil, groupInfo.Id.Uint64(), nil
+	}
+	return change, groupInfo.Id.Uint64(), nil
+}
+
+// planBucket returns the changes of the bucket and its policies. The objects can not be created by the plan, only the
+// policies of the existing objects are planned, and the objects whose policies are omitted are left untouched.
+func (c *client) planBucket(ctx context.Context, owner string, bucket *types.BucketState, groupIDs map[string]uint64,
+	opts types.PlanOptions,
+) ([]*types.ApplyChange, error) {
+	visibility, err := parseVisibility(bucket.Visibility)
+	if err != nil {
+		return nil, fmt.Errorf("invalid visibility of bucket %s: %w", bucket.BucketName, err)
+	}
+	if bucket.PaymentAddress != "" {
+		if _, err = sdk.AccAddressFromHexUnsafe(bucket.PaymentAddress); err != nil {
+			return nil, fmt.Errorf("invalid payment address of bucket %s: %w", bucket.BucketName, err)
+		}
+	}
+
+	var changes []*types.ApplyChange
+	resource := fmt.Sprintf("bucket %q", bucket.BucketName)
+	bucketInfo, err := c.HeadBucket(ctx, bucket.BucketName)
+	if err != nil {
+		if !isNotFoundErr(err) {
+			return nil, err
+		}
+		if bucket.PrimarySPAddress == "" {
+			return nil, fmt.Errorf("the primary SP address of bucket %s is required to create it", bucket.BucketName)
+		}
+		if visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+			visibility = storageTypes.VISIBILITY_TYPE_PRIVATE
+		}
+		desiredBucket := &types.BucketState{
+			BucketName:       bucket.BucketName,
+			Visibility:       visibility.String(),
+			PrimarySPAddress: bucket.PrimarySPAddress,
+			PaymentAddress:   bucket.PaymentAddress,
+			ChargedReadQuota: bucket.ChargedReadQuota,
+		}
+		diffs := []string{"primary_sp_address: " + desiredBucket.PrimarySPAddress, "visibility: " + desiredBucket.Visibility}
+		if desiredBucket.PaymentAddress != "" {
+			diffs = append(diffs, "payment_address: "+desiredBucket.PaymentAddress)
+		}
+		if desiredBucket.ChargedReadQuota != nil {
+			diffs = append(diffs, fmt.Sprintf("charged_read_quota: %d", *desiredBucket.ChargedReadQuota))
+		}
+		changes = append(changes, &types.ApplyChange{
+			Action:   types.ApplyActionCreate,
+			Kind:     types.ApplyResourceBucket,
+			Resource: resource,
+			Diffs:    diffs,
+			Bucket:   desiredBucket,
+		})
+		for _, object := range bucket.Objects {
+			if object.Policies != nil {
+				return nil, fmt.Errorf("object %s/%s does not exist, the objects can not be created by the plan", bucket.BucketName, object.ObjectName)
+			}
+		}
+		// the bucket does not exist yet, so none of its policies is pruned
+		policyChanges, err := c.planPolicies(ctx, owner, bucket.BucketName, "", bucket.Policies, groupIDs, false, opts)
+		if err != nil {
+			return nil, err
+		}
+		return append(changes, policyChanges...), nil
+	}
+
+	desiredBucket, diffs := diffBucket(bucket, bucketInfo, visibility)
+	if len(diffs) > 0 {
+		changes = append(changes, &types.ApplyChange{
+			Action:   types.ApplyActionUpdate,
+			Kind:     types.ApplyResourceBucket,
+			Resource: resource,
+			Diffs:    diffs,
+			Bucket:   desiredBucket,
+		})
+	}
+
+	policyChanges, err := c.planPolicies(ctx, owner, bucket.BucketName, "", bucket.Policies, groupIDs, opts.Prune, opts)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, policyChanges...)
+	for _, object := range bucket.Objects {
+		if object.Policies == nil {
+			continue
+		}
+		if _, err = c.HeadObject(ctx, bucket.BucketName, object.ObjectName); err != nil {
+			if isNotFoundErr(err) {
+				return nil, fmt.Errorf("object %s/%s does not exist, the objects can not be created by the plan", bucket.BucketName, object.ObjectName)
+			}
+			return nil, err
+		}
+		policyChanges, err = c.planPolicies(ctx, owner, bucket.BucketName, object.ObjectName, object.Policies, groupIDs, opts.Prune, opts)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, policyChanges...)
+	}
+	return changes, nil
+}
+
+// diffBucket returns the desired bucket updating the existing bucket and the diffs between them, the omitted fields of
+// the bucket state are kept. The charged read quota of the desired bucket is nil if it is not changed.
+func diffBucket(bucket *types.BucketState, bucketInfo *storageTypes.BucketInfo, visibility storageTypes.VisibilityType) (*types.BucketState, []string) {
+	desiredBucket := &types.BucketState{
+		BucketName:     bucket.BucketName,
+		Visibility:     bucketInfo.Visibility.String(),
+		PaymentAddress: bucketInfo.PaymentAddress,
+	}
+	var diffs []string
+	if visibility != storageTypes.VISIBILITY_TYPE_UNSPECIFIED && visibility != bucketInfo.Visibility {
+		desiredBucket.Visibility = visibility.String()
+		diffs = append(diffs, fmt.Sprintf("visibility: %s -> %s", bucketInfo.Visibility, visibility))
+	}
+	if bucket.PaymentAddress != "" && !strings.EqualFold(bucket.PaymentAddress, bucketInfo.PaymentAddress) {
+		desiredBucket.PaymentAddress = bucket.PaymentAddress
+		diffs = append(diffs, fmt.Sprintf("payment_address: %s -> %s", bucketInfo.PaymentAddress, bucket.PaymentAddress))
+	}
+	if bucket.ChargedReadQuota != nil && *bucket.ChargedReadQuota != bucketInfo.ChargedReadQuota {
+		desiredBucket.ChargedReadQuota = bucket.ChargedReadQuota
+		diffs = append(diffs, fmt.Sprintf("charged_read_quota: %d -> %d", bucketInfo.ChargedReadQuota, *bucket.ChargedReadQuota))
+	}
+	return desiredBucket, diffs
+}
+
+// planPolicies returns the changes of the policies on the bucket, or on the object if objectName is not empty. If prune
+// is set, the policies of the desired groups and the PolicyPrincipals which are not in the desired state are deleted.
+func (c *client) planPolicies(ctx context.Context, owner, bucketName, objectName string, policies []*types.PolicyState,
+	groupIDs map[string]uint64, prune bool, opts types.PlanOptions,
+) ([]*types.ApplyChange, error) {
+	resource := fmt.Sprintf("bucket %q", bucketName)
+	if objectName != "" {
+		resource = fmt.Sprintf("object %q", bucketName+"/"+objectName)
+	}
+
+	var changes []*types.ApplyChange
+	desiredPrincipals := make(map[string]bool)
+	for _, policy := range policies {
+		if (policy.PrincipalAccount == "") == (policy.PrincipalGroup == "") {
+			return nil, fmt.Errorf("either the principal account or the principal group of the policy on %s should be set", resource)
+		}
+		principal := statePrincipal{account: policy.PrincipalAccount, groupName: policy.PrincipalGroup}
+		if policy.PrincipalGroup != "" {
+			groupID, ok := groupIDs[policy.PrincipalGroup]
+			if !ok {
+				// with Prune, the groups not in the desired state are deleted, so they can not be referenced
+				if opts.Prune {
+					return nil, fmt.Errorf("group %s of the policy on %s is not in the desired state", policy.PrincipalGroup, resource)
+				}
+				groupInfo, err := c.HeadGroup(ctx, policy.PrincipalGroup, owner)
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve group %s of the policy on %s: %w", policy.PrincipalGroup, resource, err)
+				}
+				groupID = groupInfo.Id.Uint64()
+				groupIDs[policy.PrincipalGroup] = groupID
+			}
+			principal.groupID = groupID
+		} else {
+			principalAddr, err := sdk.AccAddressFromHexUnsafe(policy.PrincipalAccount)
+			if err != nil {
+				return nil, fmt.Errorf("invalid principal of the policy on %s: %w", resource, err)
+			}
+			principal.account = principalAddr.String()
+		}
+		key := principalKey(principal)
+		if desiredPrincipals[key] {
+			return nil, fmt.Errorf("the policy of %s on %s is duplicated", principalDescription(principal), resource)
+		}
+		desiredPrincipals[key] = true
+
+		change := &types.ApplyChange{
+			Kind:       types.ApplyResourcePolicy,
+			Resource:   fmt.Sprintf("policy of %s on %s", principalDescription(principal), resource),
+			BucketName: bucketName,
+			ObjectName: objectName,
+			Policy:     policy,
+		}
+		// the group to be created has no policy yet
+		var current *permTypes.Policy
+		if principal.groupName == "" || principal.groupID != 0 {
+			var err error
+			if current, err = c.getStatePolicy(ctx, bucketName, objectName, principal); err != nil {
+				return nil, err
+			}
+		}
+		if current == nil {
+			change.Action = types.ApplyActionCreate
+			change.Diffs = diffPolicy(nil, policy)
+		} else if change.Diffs = diffPolicy(current, policy); len(change.Diffs) > 0 {
+			change.Action = types.ApplyActionUpdate
+		} else {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	if !prune {
+		return changes, nil
+	}
+
+	var prunedPrincipals []statePrincipal
+	groupNames := make([]string, 0, len(groupIDs))
+	for groupName := range groupIDs {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+	for _, groupName := range groupNames {
+		if groupIDs[groupName] != 0 {
+			prunedPrincipals = append(prunedPrincipals, statePrincipal{groupName: groupName, groupID: groupIDs[groupName]})
+		}
+	}
+	for _, principalAddr := range opts.PolicyPrincipals {
+		prunedPrincipals = append(prunedPrincipals, statePrincipal{account: sdk.MustAccAddressFromHex(principalAddr).String()})
+	}
+	for _, principal := range prunedPrincipals {
+		if desiredPrincipals[principalKey(principal)] {
+			continue
+		}
+		current, err := c.getStatePolicy(ctx, bucketName, objectName, principal)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			continue
+		}
+		changes = append(changes, &types.ApplyChange{
+			Action:     types.ApplyActionDelete,
+			Kind:       types.ApplyResourcePolicy,
+			Resource:   fmt.Sprintf("policy of %s on %s", principalDescription(principal), resource),
+			BucketName: bucketName,
+			ObjectName: objectName,
+			Policy:     &types.PolicyState{PrincipalAccount: principal.account, PrincipalGroup: principal.groupName},
+		})
+	}
+	return changes, nil
+}
+
+// getStatePolicy queries the policy of the principal on the bucket or the object, it returns nil if there is no policy
+func (c *client) getStatePolicy(ctx context.Context, bucketName, objectName string, principal statePrincipal) (*permTypes.Policy, error) {
+	policies, err := c.exportPolicies(ctx, bucketName, objectName, []statePrincipal{principal})
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+	return &permTypes.Policy{Statements: policies[0].Statements, ExpirationTime: policies[0].ExpirationTime}, nil
+}
+
+// ApplyAccountState creates the buckets and the groups of the plan first, as the policies granted to the groups are
+// resolved by the group ids, then applies the other changes in the order of the plan
+func (c *client) ApplyAccountState(ctx context.Context, plan *types.ApplyPlan, opts types.ApplyOptions) (*types.ApplyResult, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	operator := account.GetAddress()
+	if plan == nil {
+		return nil, errors.New("the plan is empty")
+	}
+	if !strings.EqualFold(plan.Owner, operator.String()) {
+		return nil, fmt.Errorf("the plan is made for %s rather than the default account %s", plan.Owner, operator.String())
+	}
+
+	var creates, others []*types.ApplyChange
+	for _, change := range plan.Changes {
+		if change.Kind != types.ApplyResourcePolicy && change.Action == types.ApplyActionCreate {
+			creates = append(creates, change)
+		} else {
+			others = append(others, change)
+		}
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = types.DefaultApplyBatchSize
+	}
+	result := &types.ApplyResult{}
+	groupPrincipals := make(map[string]types.Principal)
+	for _, stage := range [][]*types.ApplyChange{creates, others} {
+		for start := 0; start < len(stage); start += batchSize {
+			end := start + batchSize
+			if end > len(stage) {
+				end = len(stage)
+			}
+			// the msgs are built right before they are broadcast, so that the approvals of the SPs do not expire
+			var msgs []sdk.Msg
+			for _, change := range stage[start:end] {
+				changeMsgs, err := c.buildApplyMsgs(ctx, operator, change, groupPrincipals)
+				if err != nil {
+					return result, fmt.Errorf("failed to %s %s: %w", change.Action, change.Resource, err)
+				}
+				msgs = append(msgs, changeMsgs...)
+			}
+			txnHash, err := c.broadcastAndWait(ctx, msgs, opts.TxOpts)
+			if err != nil {
+				return result, err
+			}
+			result.Applied += end - start
+			result.TxHashes = append(result.TxHashes, txnHash)
+		}
+	}
+	return result, nil
+}
+
+// buildApplyMsgs constructs the msgs of the change with the default account as the operator
+func (c *client) buildApplyMsgs(ctx context.Context, operator sdk.AccAddress, change *types.ApplyChange,
+	groupPrincipals map[string]types.Principal,
+) ([]sdk.Msg, error) {
+	switch change.Kind {
+	case types.ApplyResourceBucket:
+		if change.Bucket == nil {
+			return nil, errors.New("the bucket of the change is empty")
+		}
+		bucketName := change.Bucket.BucketName
+		if change.Action == types.ApplyActionDelete {
+			return []sdk.Msg{storageTypes.NewMsgDeleteBucket(operator, bucketName)}, nil
+		}
+		visibility, err := parseVisibility(change.Bucket.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		if change.Action == types.ApplyActionCreate {
+			createOpts := types.CreateBucketOptions{
+				Visibility:     visibility,
+				PaymentAddress: change.Bucket.PaymentAddress,
+			}
+			if change.Bucket.ChargedReadQuota != nil {
+				createOpts.ChargedQuota = *change.Bucket.ChargedReadQuota
+			}
+			msg, err := c.BuildCreateBucketMsg(ctx, bucketName, change.Bucket.PrimarySPAddress, createOpts)
+			if err != nil {
+				return nil, err
+			}
+			return []sdk.Msg{msg}, nil
+		}
+		paymentAddr, err := sdk.AccAddressFromHexUnsafe(change.Bucket.PaymentAddress)
+		if err != nil {
+			return nil, err
+		}
+		// the charged read quota is kept if it is nil
+		return []sdk.Msg{storageTypes.NewMsgUpdateBucketInfo(operator, bucketName, change.Bucket.ChargedReadQuota, paymentAddr, visibility)}, nil
+
+	case types.ApplyResourceGroup:
+		if change.Group == nil {
+			return nil, errors.New("the group of the change is empty")
+		}
+		groupName := change.Group.GroupName
+		var msgs []sdk.Msg
+		switch change.Action {
+		case types.ApplyActionDelete:
+			return []sdk.Msg{storageTypes.NewMsgDeleteGroup(operator, groupName)}, nil
+		case types.ApplyActionCreate:
+			msgs = append(msgs, storageTypes.NewMsgCreateGroup(operator, groupName, change.Group.Extra))
+		default:
+			if change.UpdateExtra {
+				msgs = append(msgs, storageTypes.NewMsgUpdateGroupExtra(operator, operator, groupName, change.Group.Extra))
+			}
+		}
+		if len(change.AddMembers) > 0 {
+			addMembers := make([]*storageTypes.MsgGroupMember, 0, len(change.AddMembers))
+			for _, member := range change.AddMembers {
+				addMembers = append(addMembers, &storageTypes.MsgGroupMember{Member: member, ExpirationTime: storageTypes.MaxTimeStamp})
+			}
+			msgs = append(msgs, storageTypes.NewMsgUpdateGroupMember(operator, operator, groupName, addMembers, nil))
+		}
+		return msgs, nil
+
+	case types.ApplyResourcePolicy:
+		if change.Policy == nil {
+			return nil, errors.New("the policy of the change is empty")
+		}
+		var principal types.Principal
+		if change.Policy.PrincipalGroup != "" {
+			var ok bool
+			if principal, ok = groupPrincipals[change.Policy.PrincipalGroup]; !ok {
+				var err error
+				if principal, err = c.newGroupPrincipal(ctx, operator.String(), change.Policy.PrincipalGroup); err != nil {
+					return nil, err
+				}
+				groupPrincipals[change.Policy.PrincipalGroup] = principal
+			}
+		} else {
+			principalAddr, err := sdk.AccAddressFromHexUnsafe(change.Policy.PrincipalAccount)
+			if err != nil {
+				return nil, err
+			}
+			if principal, err = utils.NewPrincipalWithAccount(principalAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		if change.Action == types.ApplyActionDelete {
+			permPrincipal := &permTypes.Principal{}
+			if err := permPrincipal.Unmarshal([]byte(principal)); err != nil {
+				return nil, err
+			}
+			resource := gnfdTypes.NewBucketGRN(change.BucketName).String()
+			if change.ObjectName != "" {
+				resource = gnfdTypes.NewObjectGRN(change.BucketName, change.ObjectName).String()
+			}
+			return []sdk.Msg{storageTypes.NewMsgDeletePolicy(operator, resource, permPrincipal)}, nil
+		}
+		putOpt := types.PutPolicyOption{PolicyExpireTime: change.Policy.ExpirationTime}
+		var (
+			msg *storageTypes.MsgPutPolicy
+			err error
+		)
+		if change.ObjectName == "" {
+			msg, err = c.BuildPutBucketPolicyMsg(change.BucketName, principal, change.Policy.Statements, putOpt)
+		} else {
+			msg, err = c.BuildPutObjectPolicyMsg(change.BucketName, change.ObjectName, principal, change.Policy.Statements, putOpt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{msg}, nil
+	}
+	return nil, fmt.Errorf("unknown resource kind %s", change.Kind)
+}
+
+// diffPolicy describes the statements and the expiration time to be changed from the current policy, which is nil
+// if the policy is to be created
+func diffPolicy(current *permTypes.Policy, desired *types.PolicyState) []string {
+	var diffs []string
+	if current == nil {
+		for _, statement := range desired.Statements {
+			diffs = append(diffs, "statement: + "+describeStatement(statement))
+		}
+		if desired.ExpirationTime != nil {
+			diffs = append(diffs, "expiration_time: "+desired.ExpirationTime.UTC().String())
+		}
+		return diffs
+	}
+
+	for _, statement := range current.Statements {
+		if !containsStatement(desired.Statements, statement) {
+			diffs = append(diffs, "statement: - "+describeStatement(statement))
+		}
+	}
+	for _, statement := range desired.Statements {
+		if !containsStatement(current.Statements, statement) {
+			diffs = append(diffs, "statement: + "+describeStatement(statement))
+		}
+	}
+	// the same statements are duplicated
+	if len(diffs) == 0 && len(current.Statements) != len(desired.Statements) {
+		diffs = append(diffs, fmt.Sprintf("statements: %d -> %d", len(current.Statements), len(desired.Statements)))
+	}
+	switch {
+	case current.ExpirationTime == nil && desired.ExpirationTime == nil:
+	case current.ExpirationTime == nil:
+		diffs = append(diffs, "expiration_time: none -> "+desired.ExpirationTime.UTC().String())
+	case desired.ExpirationTime == nil:
+		diffs = append(diffs, "expiration_time: "+current.ExpirationTime.UTC().String()+" -> none")
+	case !current.ExpirationTime.Equal(*desired.ExpirationTime):
+		diffs = append(diffs, "expiration_time: "+current.ExpirationTime.UTC().String()+" -> "+desired.ExpirationTime.UTC().String())
+	}
+	return diffs
+}
+
+func containsStatement(statements []*permTypes.Statement, statement *permTypes.Statement) bool {
+	for _, s := range statements {
+		if proto.Equal(s, statement) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeStatement prints the statement in one line, e.g. "EFFECT_ALLOW [ACTION_GET_OBJECT] on [grn:o::foo/*]"
+func describeStatement(statement *permTypes.Statement) string {
+	description := fmt.Sprintf("%s %v", statement.Effect, statement.Actions)
+	if len(statement.Resources) > 0 {
+		description += fmt.Sprintf(" on %v", statement.Resources)
+	}
+	return description
+}
+
+func principalKey(principal statePrincipal) string {
+	if principal.groupName != "" {
+		return "group:" + principal.groupName
+	}
+	return "account:" + principal.account
+}
+
+func principalDescription(principal statePrincipal) string {
+	if principal.groupName != "" {
+		return fmt.Sprintf("group %q", principal.groupName)
+	}
+	return "account " + principal.account
+}
+
+// parseVisibility parses the name of the visibility, the empty name is parsed as VISIBILITY_TYPE_UNSPECIFIED
+func parseVisibility(name string) (storageTypes.VisibilityType, error) {
+	if name == "" {
+		return storageTypes.VISIBILITY_TYPE_UNSPECIFIED, nil
+	}
+	visibility, ok := storageTypes.VisibilityType_value[name]
+	if !ok {
+		return storageTypes.VISIBILITY_TYPE_UNSPECIFIED, fmt.Errorf("unknown visibility %s", name)
+	}
+	return storageTypes.VisibilityType(visibility), nil
+}
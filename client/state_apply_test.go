@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+func TestDiffBucketChargedReadQuota(t *testing.T) {
+	bucketInfo := &storageTypes.BucketInfo{
+		BucketName:       "bucket",
+		Visibility:       storageTypes.VISIBILITY_TYPE_PRIVATE,
+		ChargedReadQuota: 1024,
+	}
+
+	// the omitted quota keeps the paid quota of the bucket
+	state, err := types.ParseAccountState([]byte("buckets:\n- bucket_name: bucket\n  visibility: VISIBILITY_TYPE_PRIVATE\n"))
+	require.NoError(t, err)
+	require.Nil(t, state.Buckets[0].ChargedReadQuota)
+	desiredBucket, diffs := diffBucket(state.Buckets[0], bucketInfo, storageTypes.VISIBILITY_TYPE_PRIVATE)
+	require.Empty(t, diffs)
+	require.Nil(t, desiredBucket.ChargedReadQuota)
+
+	// the quota set to zero explicitly is planned
+	state, err = types.ParseAccountState([]byte("buckets:\n- bucket_name: bucket\n  charged_read_quota: 0\n"))
+	require.NoError(t, err)
+	desiredBucket, diffs = diffBucket(state.Buckets[0], bucketInfo, storageTypes.VISIBILITY_TYPE_UNSPECIFIED)
+	require.Equal(t, []string{"charged_read_quota: 1024 -> 0"}, diffs)
+	require.NotNil(t, desiredBucket.ChargedReadQuota)
+	require.Equal(t, uint64(0), *desiredBucket.ChargedReadQuota)
+
+	// the unchanged quota is not planned
+	quota := uint64(1024)
+	_, diffs = diffBucket(&types.BucketState{BucketName: "bucket", ChargedReadQuota: &quota}, bucketInfo, storageTypes.VISIBILITY_TYPE_UNSPECIFIED)
+	require.Empty(t, diffs)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// preflightAccount checks the signer of the tx exists on chain, otherwise querying its sequence and simulating the
+// tx fail with the errors of the auth module
+func (c *client) preflightAccount(ctx context.Context, txOpt *gnfdSdkTypes.TxOption) error {
+	signer := (*txOpt.OverrideKeyManager).GetAddr()
+	_, err := c.chainClient.Account(ctx, &authTypes.QueryAccountRequest{Address: signer.String()})
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s, it is created by receiving a transfer, e.g. CreateAccountOnChain", types.ErrorAccountNotFound, signer.String())
+	}
+	return err
+}
+
+// preflightBalance checks the payer of the fee has enough balance of every fee coin, the fee paid by a fee grant is
+// not checked as the allowance is verified by the chain
+func (c *client) preflightBalance(ctx context.Context, txOpt *gnfdSdkTypes.TxOption) error {
+	if txOpt.FeeGranter != nil {
+		return nil
+	}
+	payer := txOpt.FeePayer
+	if payer == nil {
+		payer = (*txOpt.OverrideKeyManager).GetAddr()
+	}
+	for _, fee := range txOpt.FeeAmount {
+		resp, err := c.chainClient.BankQueryClient.Balance(ctx, &bankTypes.QueryBalanceRequest{Address: payer.String(), Denom: fee.Denom})
+		if err != nil {
+			return err
+		}
+		balance := sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+		if resp.Balance != nil {
+			balance = *resp.Balance
+		}
+		if balance.IsLT(fee) {
+			return &types.InsufficientFundsError{Address: payer.String(), Required: fee, Balance: balance}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// sentTx is a tx broadcast by the client, the sequence and the fee are resolved in txOpt, the sequence is the one
+// actually used even if it is allocated by the client
+type sentTx struct {
+	msgs  []sdk.Msg
+	txOpt gnfdSdkTypes.TxOption
+}
+
+// rememberSentTx keeps the tx for the resubmission, the oldest tx is dropped when there are SentTxCacheSize txs
+func (c *client) rememberSentTx(txHash string, msgs []sdk.Msg, txOpt gnfdSdkTypes.TxOption) {
+	c.sentTxMutex.Lock()
+	defer c.sentTxMutex.Unlock()
+	if c.sentTxs == nil {
+		c.sentTxs = make(map[string]*sentTx)
+	}
+	txHash = strings.ToUpper(txHash)
+	if _, ok := c.sentTxs[txHash]; ok {
+		return
+	}
+	if len(c.sentTxHashes) >= types.SentTxCacheSize {
+		delete(c.sentTxs, c.sentTxHashes[0])
+		c.sentTxHashes = c.sentTxHashes[1:]
+	}
+	c.sentTxs[txHash] = &sentTx{msgs: msgs, txOpt: txOpt}
+	c.sentTxHashes = append(c.sentTxHashes, txHash)
+}
+
+func (c *client) lookupSentTx(txHash string) (*sentTx, bool) {
+	c.sentTxMutex.Lock()
+	defer c.sentTxMutex.Unlock()
+	sent, ok := c.sentTxs[strings.ToUpper(txHash)]
+	return sent, ok
+}
+
+// ResubmitWithHigherFee rebuilds the tx sent by the client with the same msgs, sequence and gas limit, and broadcasts
+// it with the fee multiplied by bumpFactor
+func (c *client) ResubmitWithHigherFee(ctx context.Context, txHash string, bumpFactor float64) (*tx.BroadcastTxResponse, error) {
+	sent, ok := c.lookupSentTx(txHash)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", types.ErrorSentTxNotFound, txHash)
+	}
+	if txResponse, err := c.chainClient.Tx(ctx, txHash); err == nil && txResponse != nil {
+		return nil, fmt.Errorf("the tx %s has been committed at height %d", txHash, txResponse.Height)
+	}
+	return c.resubmitWithHigherFee(ctx, sent.msgs, sent.txOpt, bumpFactor)
+}
+
+// ResubmitMsgsWithHigherFee simulates the fee of the msgs if it is not set in txOpt by NoSimulate, and broadcasts them
+// with the fee multiplied by bumpFactor and the sequence of txOpt.Nonce
+func (c *client) ResubmitMsgsWithHigherFee(ctx context.Context, msgs []sdk.Msg, txOpt gnfdSdkTypes.TxOption, bumpFactor float64) (*tx.BroadcastTxResponse, error) {
+	txOpt, err := c.withSigner(txOpt)
+	if err != nil {
+		return nil, err
+	}
+	if !txOpt.NoSimulate {
+		simulateRes, err := c.chainClient.SimulateTx(ctx, msgs, &txOpt)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice, err := c.gasPrice(simulateRes.GasInfo.GetMinGasPrice())
+		if err != nil {
+			return nil, err
+		}
+		gasLimit := simulateRes.GasInfo.GetGasUsed()
+		txOpt.NoSimulate = true
+		txOpt.GasLimit = gasLimit
+		txOpt.FeeAmount = sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(sdk.NewInt(int64(gasLimit)))))
+	}
+	return c.resubmitWithHigherFee(ctx, msgs, txOpt, bumpFactor)
+}
+
+// resubmitWithHigherFee bumps the fee of txOpt, the bumped gas price is bounded by the MaxGasPrice of the fee policy
+func (c *client) resubmitWithHigherFee(ctx context.Context, msgs []sdk.Msg, txOpt gnfdSdkTypes.TxOption, bumpFactor float64) (*tx.BroadcastTxResponse, error) {
+	if bumpFactor <= 1 {
+		return nil, errors.New("the bump factor should be greater than 1")
+	}
+	if txOpt.GasLimit == 0 || txOpt.FeeAmount.IsZero() {
+		return nil, gnfdSdkTypes.GasInfoNotProvidedError
+	}
+
+	factor := sdk.NewDecWithPrec(int64(bumpFactor*1e6), 6)
+	bumpedFee := make(sdk.Coins, 0, len(txOpt.FeeAmount))
+	for _, fee := range txOpt.FeeAmount {
+		bumpedFee = append(bumpedFee, sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(factor).Ceil().TruncateInt()))
+	}
+	if c.feePolicy != nil && !c.feePolicy.MaxGasPrice.Amount.IsNil() && c.feePolicy.MaxGasPrice.IsPositive() {
+		maxFee := c.feePolicy.MaxGasPrice.Amount.Mul(sdk.NewIntFromUint64(txOpt.GasLimit))
+		if fee := bumpedFee.AmountOf(c.feePolicy.MaxGasPrice.Denom); fee.GT(maxFee) {
+			return nil, fmt.Errorf("%w: the bumped fee %s%s > %s%s", types.ErrorGasPriceExceedsCap,
+				fee, c.feePolicy.MaxGasPrice.Denom, maxFee, c.feePolicy.MaxGasPrice.Denom)
+		}
+	}
+	txOpt.FeeAmount = bumpedFee.Sort()
+	// the tx replaces the one of the same sequence, so the sequence is never allocated again even if it is zero
+	return c.broadcastTxWithNonce(ctx, msgs, &txOpt, true)
+}
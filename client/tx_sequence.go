@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+)
+
+// accountSequence tracks the next sequence of an account. The lock is held from the allocation of the sequence until
+// the tx passes CheckTx, so the concurrent txs of the account reach the mempool in the order of their sequences.
+type accountSequence struct {
+	mutex sync.Mutex
+	next  uint64
+	// known is false before the first tx and after a failed tx, then the sequence is queried from the chain
+	known bool
+}
+
+// accountSequence returns the sequence tracker of the account
+func (c *client) accountSequence(addr sdk.AccAddress) *accountSequence {
+	c.sequenceMutex.Lock()
+	defer c.sequenceMutex.Unlock()
+	if c.sequences == nil {
+		c.sequences = make(map[string]*accountSequence)
+	}
+	seq, ok := c.sequences[addr.String()]
+	if !ok {
+		seq = &accountSequence{}
+		c.sequences[addr.String()] = seq
+	}
+	return seq
+}
+
+// sendTxWithSequence allocates the next sequence of the signer of txOpt and broadcasts the msgs with it. The sequence
+// is queried from the chain again if the tx fails, e.g. the account is also used by another client.
+func (c *client) sendTxWithSequence(ctx context.Context, msgs []sdk.Msg, txOpt *gnfdSdkTypes.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	seq := c.accountSequence((*txOpt.OverrideKeyManager).GetAddr())
+	seq.mutex.Lock()
+	defer seq.mutex.Unlock()
+
+	if !seq.known {
+		nonce, err := c.chainClient.GetNonceByAddr(ctx, (*txOpt.OverrideKeyManager).GetAddr())
+		if err != nil {
+			return nil, err
+		}
+		seq.next = nonce
+	}
+	txOpt.Nonce = seq.next
+	resp, err := c.sendTx(ctx, msgs, txOpt, opts...)
+	if err != nil || resp.TxResponse.Code != 0 {
+		seq.known = false
+		return resp, err
+	}
+	seq.next, seq.known = txOpt.Nonce+1, true
+	return resp, nil
+}
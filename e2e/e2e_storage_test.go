@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -69,9 +70,14 @@ func (s *StorageTestSuite) Test_Bucket() {
 	updateBucketTx, err := s.Client.UpdateBucketVisibility(s.ClientContext, bucketName,
 		storageTypes.VISIBILITY_TYPE_PUBLIC_READ, types.UpdateVisibilityOption{})
 	s.Require().NoError(err)
-	_, err = s.Client.WaitForTx(s.ClientContext, updateBucketTx)
+	updateBucketResult, err := s.Client.WaitForTx(s.ClientContext, updateBucketTx)
 	s.Require().NoError(err)
 
+	s.T().Log("---> HeadBucket at the height before the update <---")
+	historicalBucketInfo, err := s.Client.HeadBucket(types.WithQueryHeight(s.ClientContext, updateBucketResult.Height-1), bucketName)
+	s.Require().NoError(err)
+	s.Require().Equal(storageTypes.VISIBILITY_TYPE_PRIVATE, historicalBucketInfo.Visibility)
+
 	s.T().Log("---> BuyQuotaForBucket <---")
 	targetQuota := uint64(300)
 	buyQuotaTx, err := s.Client.BuyQuotaForBucket(s.ClientContext, bucketName, targetQuota, types.BuyQuotaOption{})
@@ -84,6 +90,10 @@ func (s *StorageTestSuite) Test_Bucket() {
 	s.Require().NoError(err)
 	s.Require().Equal(quota.ReadQuotaSize, targetQuota)
 
+	quotaSummary, err := s.Client.GetBucketQuotaSummary(s.ClientContext, bucketName)
+	s.Require().NoError(err)
+	s.Require().Equal(quotaSummary.ChargedQuota, targetQuota)
+
 	s.T().Log("---> PutBucketPolicy <---")
 	principal, _, err := types.NewAccount("principal")
 	s.Require().NoError(err)
@@ -229,6 +239,53 @@ func (s *StorageTestSuite) Test_Object() {
 	s.Require().Error(err)
 }
 
+// Test_Concurrent_Create_And_Put_Objects creates and uploads the objects from the goroutines sharing the client, the
+// txs of the default account must not conflict on the sequences. Run it with -race to check the internal locking.
+func (s *StorageTestSuite) Test_Concurrent_Create_And_Put_Objects() {
+	bucketName := storageTestUtil.GenRandomBucketName()
+	bucketTx, err := s.Client.CreateBucket(s.ClientContext, bucketName, s.PrimarySP.OperatorAddress, types.CreateBucketOptions{})
+	s.Require().NoError(err)
+	_, err = s.Client.WaitForTx(s.ClientContext, bucketTx)
+	s.Require().NoError(err)
+
+	const concurrency = 8
+	var (
+		wg      sync.WaitGroup
+		errs    = make([]error, concurrency)
+		objects = make([]string, concurrency)
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			objectName := storageTestUtil.GenRandomObjectName()
+			objects[i] = objectName
+			payload := bytes.Repeat([]byte(fmt.Sprintf("%d", i)), 1024*(i+1))
+			objectTx, err := s.Client.CreateObject(s.ClientContext, bucketName, objectName, bytes.NewReader(payload), types.CreateObjectOptions{})
+			if err != nil {
+				errs[i] = fmt.Errorf("create object %s: %w", objectName, err)
+				return
+			}
+			if _, err = s.Client.WaitForTx(s.ClientContext, objectTx); err != nil {
+				errs[i] = fmt.Errorf("wait for object %s: %w", objectName, err)
+				return
+			}
+			if err = s.Client.PutObject(s.ClientContext, bucketName, objectName, int64(len(payload)),
+				bytes.NewReader(payload), types.PutObjectOptions{}); err != nil {
+				errs[i] = fmt.Errorf("put object %s: %w", objectName, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		s.Require().NoError(err)
+	}
+
+	for _, objectName := range objects {
+		s.waitSealObject(bucketName, objectName)
+	}
+}
+
 func (s *StorageTestSuite) Test_Group() {
 	groupName := storageTestUtil.GenRandomGroupName()
 
@@ -560,3 +617,26 @@ func (s *StorageTestSuite) Test_Resumable_Upload_And_Download() {
 	s.Require().True(isSame)
 	s.Require().NoError(err)
 }
+
+func (s *StorageTestSuite) Test_SPHealth() {
+	latency, err := s.Client.PingSP(s.ClientContext, s.PrimarySP.OperatorAddress)
+	s.Require().NoError(err)
+	s.T().Logf("ping sp %s latency: %s", s.PrimarySP.Endpoint, latency)
+
+	cli, err := client.New(basesuite.ChainID, basesuite.Endpoint, client.Option{
+		DefaultAccount:        s.DefaultAccount,
+		SPHealthCheckInterval: time.Second,
+	})
+	s.Require().NoError(err)
+	defer cli.Close()
+
+	// the health of the primary SP is recorded by the background checker after it is probed
+	s.Require().Eventually(func() bool {
+		for _, health := range cli.GetSPHealth() {
+			if health.Id == s.PrimarySP.Id {
+				return health.Available
+			}
+		}
+		return false
+	}, 10*time.Second, 500*time.Millisecond)
+}
@@ -54,7 +54,8 @@ func main() {
 	log.Printf("add group member: %s to group: %s successfully \n", groupMember, groupName)
 
 	// head group member
-	memIsExist := cli.HeadGroupMember(ctx, groupName, creator.GetAddress().String(), groupMember)
+	memIsExist, err := cli.HeadGroupMemberE(ctx, groupName, creator.GetAddress().String(), groupMember)
+	handleErr(err, "HeadGroupMember")
 	if !memIsExist {
 		log.Fatalf("head group member %s fail \n", groupMember)
 	}
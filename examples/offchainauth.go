@@ -28,7 +28,7 @@ func main() {
 	ctx := context.Background()
 	// list object
 	objects, err := cli.ListObjects(ctx, bucketName, types.ListObjectsOptions{
-		true, "", "", "/", "", 10, &types.EndPointOptions{
+		ShowRemovedObject: true, Delimiter: "/", MaxKeys: 10, EndPointOptions: &types.EndPointOptions{
 			Endpoint:  "",
 			SPAddress: "",
 		},
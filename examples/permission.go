@@ -76,34 +76,20 @@ func main() {
 		log.Fatalln("txn fail")
 	}
 
-	// put object policy
+	// grant object access, the policy is put and verified to be effective
 	objectActions := []permTypes.ActionType{
 		permTypes.ACTION_DELETE_OBJECT,
 		permTypes.ACTION_GET_OBJECT,
 	}
-	statements = utils.NewStatement(objectActions, permTypes.EFFECT_ALLOW, nil, types.NewStatementOptions{})
-	policyTx, err = cli.PutObjectPolicy(ctx, bucketName, objectName, principalStr, []*permTypes.Statement{&statements},
-		types.PutPolicyOption{})
-	handleErr(err, "PutObjectPolicy")
-	_, err = cli.WaitForTx(ctx, policyTx)
-	if err != nil {
-		log.Fatalln("txn fail")
-	}
-	log.Printf("put object: %s policy sucessfully, principal is: %s.\n", objectName, principal)
+	_, err = cli.GrantObjectAccess(ctx, bucketName, objectName, principal, objectActions, nil)
+	handleErr(err, "GrantObjectAccess")
+	log.Printf("grant object: %s access sucessfully, principal is: %s.\n", objectName, principal)
 
 	// get object policy
 	policyInfo, err = cli.GetObjectPolicy(ctx, bucketName, objectName, principal)
 	handleErr(err, "GetObjectPolicy")
 	log.Printf("object: %s policy info:%s\n", bucketName, policyInfo.String())
 
-	// verify permission
-	effect, err = cli.IsObjectPermissionAllowed(ctx, principal, bucketName, objectName, permTypes.ACTION_DELETE_OBJECT)
-	handleErr(err, "IsObjectPermissionAllowed")
-
-	if effect != permTypes.EFFECT_ALLOW {
-		log.Fatalln("permission not allowed to:", principal)
-	}
-
 	// delete object permission
 	policyTx, err = cli.DeleteObjectPolicy(ctx, bucketName, objectName, principalStr, types.DeletePolicyOption{})
 	handleErr(err, "DeleteObjectPolicy")
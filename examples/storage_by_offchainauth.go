@@ -82,7 +82,7 @@ func main() {
 
 	// list object
 	objects, err := cli.ListObjects(ctx, bucketName, types.ListObjectsOptions{
-		true, "", "", "/", "", 10, &types.EndPointOptions{
+		ShowRemovedObject: true, Delimiter: "/", MaxKeys: 10, EndPointOptions: &types.EndPointOptions{
 			Endpoint:  httpsAddr,
 			SPAddress: "",
 		},
@@ -0,0 +1,84 @@
+// Package eip712 exposes the EIP-712 typed data construction and digest computation used to sign
+// Greenfield transactions, so that the signature can be produced outside the SDK (e.g. by a hardware
+// wallet or a remote signer) and the signed transaction broadcast with BroadcastRawTx.
+package eip712
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// NewTxConfig returns the tx config which encodes, decodes and signs transactions in EIP-712 sign mode
+func NewTxConfig(cdc codec.ProtoCodecMarshaler) client.TxConfig {
+	return authtx.NewTxConfig(cdc, []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})
+}
+
+// GetTypedData constructs the EIP-712 typed data of the transaction that the signer needs to sign.
+// chainID indicates the chain id of greenfield, e.g. "greenfield_1017-1".
+func GetTypedData(tx sdk.Tx, chainID string, accountNumber, sequence uint64) (apitypes.TypedData, error) {
+	typedChainID, err := sdk.ParseChainID(chainID)
+	if err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("failed to parse chainID %s: %w", chainID, err)
+	}
+	signerData := xauthsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}
+	msgTypes, signDoc, err := authtx.GetMsgTypes(signerData, tx, typedChainID)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return authtx.WrapTxToTypedData(typedChainID.Uint64(), signDoc, msgTypes)
+}
+
+// ComputeDigest computes the keccak256 digest of the typed data, which is the payload to be signed
+func ComputeDigest(typedData apitypes.TypedData) ([]byte, error) {
+	return authtx.ComputeTypedDataHash(typedData)
+}
+
+// GetSignBytes returns the digest of the transaction that the signer needs to sign
+func GetSignBytes(tx sdk.Tx, chainID string, accountNumber, sequence uint64) ([]byte, error) {
+	typedData, err := GetTypedData(tx, chainID, accountNumber, sequence)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeDigest(typedData)
+}
+
+// EncodeSignedTx attaches the externally produced signature of the signer to the transaction and
+// returns the encoded bytes which can be broadcast by BroadcastRawTx.
+// The signature must be signed over the digest returned by GetSignBytes with the same sequence.
+func EncodeSignedTx(txConfig client.TxConfig, tx sdk.Tx, pubKey cryptotypes.PubKey, sequence uint64, signature []byte) ([]byte, error) {
+	if pubKey == nil {
+		return nil, errors.New("public key of the signer is empty")
+	}
+	if len(signature) == 0 {
+		return nil, errors.New("signature is empty")
+	}
+	txBuilder, err := txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	sig := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+			Signature: signature,
+		},
+		Sequence: sequence,
+	}
+	if err = txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+	return txConfig.TxEncoder()(txBuilder.GetTx())
+}
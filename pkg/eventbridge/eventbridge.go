@@ -0,0 +1,448 @@
+// Package eventbridge follows the blocks of the chain and delivers the lifecycle events of the objects in the
+// configured buckets as normalized JSON to webhook endpoints and to pluggable sinks, e.g. Kafka topics and NATS
+// subjects, for triggering the processing of the uploaded objects without polling the buckets.
+package eventbridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/events"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// The types of the events delivered by the Bridge
+const (
+	EventObjectSealed  = "object.sealed"
+	EventObjectDeleted = "object.deleted"
+	// EventPolicyChanged is delivered when a policy of the bucket or of an object in the bucket is put or deleted
+	EventPolicyChanged = "policy.changed"
+)
+
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultMaxRetries   = 5
+	DefaultRetryBackoff = time.Second
+)
+
+// ChainClient is the subset of the SDK client queried by the Bridge, which is implemented by client.Client
+type ChainClient interface {
+	GetLatestBlockHeight(ctx context.Context) (int64, error)
+	GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.Block, error)
+	GetBlockResultByHeight(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error)
+	HeadBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error)
+	HeadObjectByID(ctx context.Context, objID string) (*types.ObjectDetail, error)
+}
+
+// Event is the normalized event delivered to the endpoints
+type Event struct {
+	// ID is unique for each event on the chain, the receivers can use it to drop the events delivered more than once
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	Height     int64     `json:"height"`
+	Time       time.Time `json:"time"`
+	TxHash     string    `json:"tx_hash,omitempty"`
+	BucketName string    `json:"bucket_name"`
+	ObjectName string    `json:"object_name,omitempty"`
+	ObjectID   string    `json:"object_id,omitempty"`
+	PolicyID   string    `json:"policy_id,omitempty"`
+	// PolicyDeleted indicates whether the policy of EventPolicyChanged is deleted rather than put
+	PolicyDeleted bool   `json:"policy_deleted,omitempty"`
+	Operator      string `json:"operator,omitempty"`
+}
+
+// Endpoint is a webhook receiving the events by POST requests
+type Endpoint struct {
+	URL string
+	// Secret signs the requests by HMAC-SHA256, the requests are not signed if it is empty, see VerifySignature
+	Secret []byte
+	// Types are the types of the events delivered to the endpoint, all the events are delivered if it is empty
+	Types []string
+}
+
+// Config contains the options of the Bridge
+type Config struct {
+	Buckets   []string
+	Endpoints []Endpoint
+	// Sinks receive the events besides the endpoints, e.g. NewKafkaSink, NewNATSSink and NewChannelSink
+	Sinks []Sink
+	// StartHeight is the first height to follow, the Bridge starts from the block after the latest one if it is 0,
+	// e.g. it is set to the Height of the previous run plus one to resume
+	StartHeight int64
+	// PollInterval is the interval of polling the latest height, DefaultPollInterval is used if it is not set
+	PollInterval time.Duration
+	// MaxRetries is the max number of the retries of delivering an event to a sink, DefaultMaxRetries is used if it is
+	// 0, and the event is not retried if it is negative. The errors wrapped by Permanent are not retried.
+	MaxRetries int
+	// RetryBackoff is the delay of the first retry, which is doubled for every retry, DefaultRetryBackoff is used if it
+	// is not set
+	RetryBackoff time.Duration
+	// HTTPClient sends the requests to the endpoints, a client with a timeout of 30 seconds is used if it is nil
+	HTTPClient HTTPDoer
+	// OnError is called with the errors of querying the chain, which are retried at the next poll, and with the
+	// *DeliveryError of the events which are dropped after the retries
+	OnError func(err error)
+}
+
+// DeliveryError is reported to Config.OnError when an event can not be delivered to a sink
+type DeliveryError struct {
+	// Sink is the name of the sink, which is the url for the endpoints
+	Sink  string
+	Event Event
+	Err   error
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("failed to deliver event %s to %s: %v", e.Event.ID, e.Sink, e.Err)
+}
+
+func (e *DeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Bridge follows the blocks and delivers the events of the configured buckets to the sinks. The events of a block
+// are delivered in order to each sink before the next block is followed, and the sinks are delivered concurrently.
+type Bridge struct {
+	client   ChainClient
+	config   Config
+	sinks    []Sink
+	matcher  *matcher
+	height   atomic.Int64
+	pending  int64
+	runMutex sync.Mutex
+}
+
+// New returns a Bridge following the blocks from Config.StartHeight
+func New(client ChainClient, config Config) (*Bridge, error) {
+	if client == nil {
+		return nil, errors.New("the chain client of the event bridge should not be nil")
+	}
+	if len(config.Buckets) == 0 {
+		return nil, errors.New("no bucket is configured for the event bridge")
+	}
+	if len(config.Endpoints) == 0 && len(config.Sinks) == 0 {
+		return nil, errors.New("no endpoint or sink is configured for the event bridge")
+	}
+	for _, endpoint := range config.Endpoints {
+		if endpoint.URL == "" {
+			return nil, errors.New("the url of the endpoint should not be empty")
+		}
+	}
+	for _, sink := range config.Sinks {
+		if sink == nil {
+			return nil, errors.New("the sink of the event bridge should not be nil")
+		}
+	}
+	if config.StartHeight < 0 {
+		return nil, fmt.Errorf("invalid start height %d", config.StartHeight)
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = DefaultMaxRetries
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = DefaultRetryBackoff
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = defaultHTTPClient
+	}
+	sinks := make([]Sink, 0, len(config.Endpoints)+len(config.Sinks))
+	for _, endpoint := range config.Endpoints {
+		sinks = append(sinks, FilterTypes(NewWebhookSink(endpoint, config.HTTPClient), endpoint.Types...))
+	}
+	sinks = append(sinks, config.Sinks...)
+	return &Bridge{
+		client:  client,
+		config:  config,
+		sinks:   sinks,
+		matcher: newMatcher(client, config.Buckets),
+		pending: config.StartHeight,
+	}, nil
+}
+
+// Height returns the height of the latest block whose events are delivered, or dropped after the retries
+func (b *Bridge) Height() int64 {
+	return b.height.Load()
+}
+
+// Run follows the blocks until ctx is done, it returns the error of ctx
+func (b *Bridge) Run(ctx context.Context) error {
+	if !b.runMutex.TryLock() {
+		return errors.New("the event bridge is already running")
+	}
+	defer b.runMutex.Unlock()
+
+	b.matcher.resolveBuckets(ctx)
+	ticker := time.NewTicker(b.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := b.poll(ctx); err != nil && ctx.Err() == nil {
+			b.reportError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll follows the blocks up to the latest one
+func (b *Bridge) poll(ctx context.Context) error {
+	latest, err := b.client.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the latest height: %w", err)
+	}
+	if b.pending == 0 {
+		b.pending = latest + 1
+	}
+	for ; b.pending <= latest; b.pending++ {
+		blockEvents, err := b.eventsOfBlock(ctx, b.pending)
+		if err != nil {
+			return err
+		}
+		b.deliver(ctx, blockEvents)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		b.height.Store(b.pending)
+	}
+	return nil
+}
+
+// eventsOfBlock decodes the events of the configured buckets in the block, the header of the block is only queried
+// if it contains such events
+func (b *Bridge) eventsOfBlock(ctx context.Context, height int64) ([]Event, error) {
+	blockResults, err := b.client.GetBlockResultByHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the block results of height %d: %w", height, err)
+	}
+
+	var blockEvents []Event
+	// txIndexes records the index of the transaction of each event, -1 for the events of the block
+	var txIndexes []int
+	index := 0
+	collect := func(abciEvents []abci.Event, txIndex int) error {
+		typedEvents, err := events.ParseEvents(abciEvents)
+		if err != nil {
+			return err
+		}
+		for _, typedEvent := range typedEvents {
+			// the index counts all the typed events, so the id of an event does not depend on the configured buckets
+			index++
+			event, ok := b.matcher.match(ctx, typedEvent)
+			if !ok {
+				continue
+			}
+			event.ID = fmt.Sprintf("%d-%d", height, index)
+			event.Height = height
+			blockEvents = append(blockEvents, event)
+			txIndexes = append(txIndexes, txIndex)
+		}
+		return nil
+	}
+	if err = collect(blockResults.BeginBlockEvents, -1); err != nil {
+		return nil, err
+	}
+	for i, txResult := range blockResults.TxsResults {
+		if txResult.Code != 0 {
+			continue
+		}
+		if err = collect(txResult.Events, i); err != nil {
+			return nil, err
+		}
+	}
+	if err = collect(blockResults.EndBlockEvents, -1); err != nil {
+		return nil, err
+	}
+	if len(blockEvents) == 0 {
+		return nil, nil
+	}
+
+	block, err := b.client.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the block of height %d: %w", height, err)
+	}
+	for i := range blockEvents {
+		blockEvents[i].Time = block.Time
+		if txIndex := txIndexes[i]; txIndex >= 0 && txIndex < len(block.Txs) {
+			blockEvents[i].TxHash = fmt.Sprintf("%X", block.Txs[txIndex].Hash())
+		}
+	}
+	return blockEvents, nil
+}
+
+// deliver delivers the events to the sinks concurrently, the events dropped after the retries are reported
+func (b *Bridge) deliver(ctx context.Context, blockEvents []Event) {
+	if len(blockEvents) == 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, sink := range b.sinks {
+		wg.Add(1)
+		go func(sink Sink) {
+			defer wg.Done()
+			for _, event := range blockEvents {
+				if err := b.send(ctx, sink, event); err != nil && ctx.Err() == nil {
+					b.reportError(&DeliveryError{Sink: sink.Name(), Event: event, Err: err})
+				}
+			}
+		}(sink)
+	}
+	wg.Wait()
+}
+
+// send sends the event to the sink, the errors which are not wrapped by Permanent are retried with backoff
+func (b *Bridge) send(ctx context.Context, sink Sink, event Event) error {
+	backoff := b.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := sink.Send(ctx, event)
+		var permanent *PermanentError
+		if err == nil || errors.As(err, &permanent) || attempt >= b.config.MaxRetries || ctx.Err() != nil {
+			return err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (b *Bridge) reportError(err error) {
+	if b.config.OnError != nil {
+		b.config.OnError(err)
+	}
+}
+
+// matcher normalizes the typed events of the configured buckets, the ids of the buckets and their policies are
+// learned from the events, so that the recreated buckets and the deleted policies are tracked. The policies of the
+// objects are matched by querying the objects, so the policies of the objects deleted since are not matched, neither
+// are the policies deleted which were put before the Bridge started.
+type matcher struct {
+	client ChainClient
+	// buckets maps the names of the configured buckets to their ids, which are empty if the buckets do not exist
+	buckets map[string]string
+	// bucketIDs maps the ids of the configured buckets to their names
+	bucketIDs map[string]string
+	// policies maps the ids of the matched policies to the names of their buckets
+	policies map[string]string
+}
+
+func newMatcher(client ChainClient, bucketNames []string) *matcher {
+	m := &matcher{
+		client:    client,
+		buckets:   make(map[string]string, len(bucketNames)),
+		bucketIDs: make(map[string]string, len(bucketNames)),
+		policies:  make(map[string]string),
+	}
+	for _, bucketName := range bucketNames {
+		m.buckets[bucketName] = ""
+	}
+	return m
+}
+
+// resolveBuckets looks up the ids of the existing buckets, the buckets created later are learned from the events
+func (m *matcher) resolveBuckets(ctx context.Context) {
+	for bucketName, bucketID := range m.buckets {
+		if bucketID != "" {
+			continue
+		}
+		if bucketInfo, err := m.client.HeadBucket(ctx, bucketName); err == nil {
+			m.setBucketID(bucketName, bucketInfo.Id.String())
+		}
+	}
+}
+
+func (m *matcher) setBucketID(bucketName, bucketID string) {
+	if oldID := m.buckets[bucketName]; oldID != "" {
+		delete(m.bucketIDs, oldID)
+	}
+	m.buckets[bucketName] = bucketID
+	m.bucketIDs[bucketID] = bucketName
+}
+
+func (m *matcher) watched(bucketName string) bool {
+	_, ok := m.buckets[bucketName]
+	return ok
+}
+
+func (m *matcher) match(ctx context.Context, typedEvent proto.Message) (Event, bool) {
+	switch e := typedEvent.(type) {
+	case *storageTypes.EventCreateBucket:
+		if m.watched(e.BucketName) {
+			m.setBucketID(e.BucketName, e.BucketId.String())
+		}
+		return Event{}, false
+	case *storageTypes.EventSealObject:
+		if !m.watched(e.BucketName) {
+			return Event{}, false
+		}
+		return Event{
+			Type:       EventObjectSealed,
+			BucketName: e.BucketName,
+			ObjectName: e.ObjectName,
+			ObjectID:   e.ObjectId.String(),
+			Operator:   e.Operator,
+		}, true
+	case *storageTypes.EventDeleteObject:
+		if !m.watched(e.BucketName) {
+			return Event{}, false
+		}
+		return Event{
+			Type:       EventObjectDeleted,
+			BucketName: e.BucketName,
+			ObjectName: e.ObjectName,
+			ObjectID:   e.ObjectId.String(),
+		}, true
+	case *permTypes.EventPutPolicy:
+		event := Event{Type: EventPolicyChanged, PolicyID: e.PolicyId.String()}
+		switch e.ResourceType {
+		case resource.RESOURCE_TYPE_BUCKET:
+			bucketName, ok := m.bucketIDs[e.ResourceId.String()]
+			if !ok {
+				return Event{}, false
+			}
+			event.BucketName = bucketName
+		case resource.RESOURCE_TYPE_OBJECT:
+			objectDetail, err := m.client.HeadObjectByID(ctx, e.ResourceId.String())
+			if err != nil || objectDetail.ObjectInfo == nil || !m.watched(objectDetail.ObjectInfo.BucketName) {
+				return Event{}, false
+			}
+			event.BucketName = objectDetail.ObjectInfo.BucketName
+			event.ObjectName = objectDetail.ObjectInfo.ObjectName
+			event.ObjectID = e.ResourceId.String()
+		default:
+			return Event{}, false
+		}
+		m.policies[event.PolicyID] = event.BucketName
+		return event, true
+	case *permTypes.EventDeletePolicy:
+		policyID := e.PolicyId.String()
+		bucketName, ok := m.policies[policyID]
+		if !ok {
+			return Event{}, false
+		}
+		delete(m.policies, policyID)
+		return Event{Type: EventPolicyChanged, BucketName: bucketName, PolicyID: policyID, PolicyDeleted: true}, true
+	default:
+		return Event{}, false
+	}
+}
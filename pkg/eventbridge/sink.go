@@ -0,0 +1,165 @@
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Sink receives the events delivered by the Bridge. The events of a sink are sent one by one in order, Send is
+// retried by the Bridge unless the error is wrapped by Permanent.
+type Sink interface {
+	// Name identifies the sink in the DeliveryError
+	Name() string
+	Send(ctx context.Context, event Event) error
+}
+
+// PermanentError wraps the errors of a sink which are not retried
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent marks the error as not retried, it returns nil if err is nil
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+type filterSink struct {
+	Sink
+	types map[string]bool
+}
+
+// FilterTypes returns a Sink only receiving the events of the types, it returns the sink itself if no type is given
+func FilterTypes(sink Sink, types ...string) Sink {
+	if len(types) == 0 {
+		return sink
+	}
+	filter := &filterSink{Sink: sink, types: make(map[string]bool, len(types))}
+	for _, t := range types {
+		filter.types[t] = true
+	}
+	return filter
+}
+
+func (s *filterSink) Send(ctx context.Context, event Event) error {
+	if !s.types[event.Type] {
+		return nil
+	}
+	return s.Sink.Send(ctx, event)
+}
+
+type channelSink struct {
+	ch chan<- Event
+}
+
+// NewChannelSink returns a Sink sending the events to the channel, which blocks the Bridge until the events are
+// received, e.g. for consuming the events in the same process
+func NewChannelSink(ch chan<- Event) Sink {
+	return &channelSink{ch: ch}
+}
+
+func (s *channelSink) Name() string {
+	return "channel"
+}
+
+func (s *channelSink) Send(ctx context.Context, event Event) error {
+	select {
+	case s.ch <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// KafkaProducer writes a message to a Kafka topic, it is implemented by adapting the producer of the Kafka client in
+// use, e.g. by a KafkaProducerFunc calling WriteMessages of a kafka-go Writer
+type KafkaProducer interface {
+	Produce(ctx context.Context, topic string, key, value []byte) error
+}
+
+// KafkaProducerFunc is an adapter to allow the use of ordinary functions as KafkaProducer
+type KafkaProducerFunc func(ctx context.Context, topic string, key, value []byte) error
+
+// Produce calls f(ctx, topic, key, value)
+func (f KafkaProducerFunc) Produce(ctx context.Context, topic string, key, value []byte) error {
+	return f(ctx, topic, key, value)
+}
+
+type kafkaSink struct {
+	producer KafkaProducer
+	topic    string
+}
+
+// NewKafkaSink returns a Sink writing the events as JSON to the topic. The messages are keyed by the bucket name, so
+// the events of a bucket are kept in order in a partition.
+func NewKafkaSink(producer KafkaProducer, topic string) Sink {
+	return &kafkaSink{producer: producer, topic: topic}
+}
+
+func (s *kafkaSink) Name() string {
+	return "kafka:" + s.topic
+}
+
+func (s *kafkaSink) Send(ctx context.Context, event Event) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	return s.producer.Produce(ctx, s.topic, []byte(event.BucketName), value)
+}
+
+// NATSPublisher publishes a message to a NATS subject, it is implemented by *nats.Conn, and the JetStream context can
+// be adapted by a NATSPublisherFunc to wait for the acknowledgements
+type NATSPublisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// NATSPublisherFunc is an adapter to allow the use of ordinary functions as NATSPublisher
+type NATSPublisherFunc func(subject string, data []byte) error
+
+// Publish calls f(subject, data)
+func (f NATSPublisherFunc) Publish(subject string, data []byte) error {
+	return f(subject, data)
+}
+
+type natsSink struct {
+	publisher     NATSPublisher
+	subjectPrefix string
+}
+
+// NewNATSSink returns a Sink publishing the events as JSON to the subject of the prefix followed by the event type,
+// e.g. "greenfield.events.object.sealed" for the prefix "greenfield.events", so the subscribers can filter the events
+// by wildcards such as "greenfield.events.object.>"
+func NewNATSSink(publisher NATSPublisher, subjectPrefix string) Sink {
+	return &natsSink{publisher: publisher, subjectPrefix: subjectPrefix}
+}
+
+func (s *natsSink) Name() string {
+	return "nats:" + s.subjectPrefix
+}
+
+func (s *natsSink) Send(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	subject := event.Type
+	if s.subjectPrefix != "" {
+		subject = fmt.Sprintf("%s.%s", s.subjectPrefix, event.Type)
+	}
+	return s.publisher.Publish(subject, data)
+}
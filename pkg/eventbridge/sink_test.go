@@ -0,0 +1,72 @@
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinks(t *testing.T) {
+	event := Event{ID: "10-1", Type: EventObjectSealed, Height: 10, BucketName: "bucket", ObjectName: "object"}
+	value, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	var topics, keys, values []string
+	kafka := NewKafkaSink(KafkaProducerFunc(func(_ context.Context, topic string, key, value []byte) error {
+		topics, keys, values = append(topics, topic), append(keys, string(key)), append(values, string(value))
+		return nil
+	}), "greenfield")
+	require.NoError(t, kafka.Send(context.Background(), event))
+	require.Equal(t, []string{"greenfield"}, topics)
+	require.Equal(t, []string{"bucket"}, keys)
+	require.Equal(t, []string{string(value)}, values)
+
+	var subjects []string
+	nats := NewNATSSink(NATSPublisherFunc(func(subject string, _ []byte) error {
+		subjects = append(subjects, subject)
+		return nil
+	}), "greenfield.events")
+	require.NoError(t, nats.Send(context.Background(), event))
+	// the events of the other types are filtered out
+	filtered := FilterTypes(nats, EventObjectDeleted)
+	require.Equal(t, nats.Name(), filtered.Name())
+	require.NoError(t, filtered.Send(context.Background(), event))
+	require.Equal(t, []string{"greenfield.events.object.sealed"}, subjects)
+
+	ch := make(chan Event, 1)
+	require.NoError(t, NewChannelSink(ch).Send(context.Background(), event))
+	require.Equal(t, event, <-ch)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, NewChannelSink(make(chan Event)).Send(ctx, event), context.Canceled)
+}
+
+func TestSendRetries(t *testing.T) {
+	b := &Bridge{config: Config{MaxRetries: 3, RetryBackoff: time.Millisecond}}
+	event := Event{ID: "10-1", Type: EventObjectDeleted}
+
+	attempts := 0
+	failing := errors.New("unavailable")
+	sink := NewNATSSink(NATSPublisherFunc(func(string, []byte) error {
+		attempts++
+		if attempts < 3 {
+			return failing
+		}
+		return nil
+	}), "")
+	require.NoError(t, b.send(context.Background(), sink, event))
+	require.Equal(t, 3, attempts)
+
+	// the permanent errors are not retried
+	attempts = 0
+	sink = NewNATSSink(NATSPublisherFunc(func(string, []byte) error {
+		attempts++
+		return Permanent(failing)
+	}), "")
+	require.ErrorIs(t, b.send(context.Background(), sink, event), failing)
+	require.Equal(t, 1, attempts)
+}
@@ -0,0 +1,121 @@
+package eventbridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The headers of the webhook requests
+const (
+	HeaderEventID   = "X-Gnfd-Event-Id"
+	HeaderEventType = "X-Gnfd-Event-Type"
+	// HeaderTimestamp is the unix time in seconds at which the request is signed
+	HeaderTimestamp = "X-Gnfd-Webhook-Timestamp"
+	// HeaderSignature is "sha256=" followed by the HEX-encoded HMAC-SHA256 of the timestamp, a dot and the body
+	HeaderSignature = "X-Gnfd-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// ErrInvalidSignature is returned by VerifySignature if the request is not signed by the secret or is out of the
+// tolerance
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// HTTPDoer sends the webhook requests, which is implemented by *http.Client
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+var defaultHTTPClient HTTPDoer = &http.Client{Timeout: 30 * time.Second}
+
+// Sign returns the value of HeaderSignature of the body signed at the timestamp
+func Sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature verifies the webhook request is signed by the secret within the tolerance of now, which rejects the
+// replayed requests, e.g. VerifySignature(secret, r.Header, body, 5*time.Minute, time.Now())
+func VerifySignature(secret []byte, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrInvalidSignature, header.Get(HeaderTimestamp))
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("%w: the request is signed at %d, which is out of the tolerance %s", ErrInvalidSignature, timestamp, tolerance)
+	}
+	signature := header.Get(HeaderSignature)
+	if !strings.HasPrefix(signature, signaturePrefix) ||
+		!hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return fmt.Errorf("%w: the signature does not match", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// webhookSink posts the events to an endpoint, the 4xx responses except 429 are not retried
+type webhookSink struct {
+	endpoint Endpoint
+	client   HTTPDoer
+}
+
+// NewWebhookSink returns a Sink posting the events to the endpoint, the request is signed again for every attempt.
+// The types of the endpoint are not filtered by the sink, see FilterTypes.
+func NewWebhookSink(endpoint Endpoint, client HTTPDoer) Sink {
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	return &webhookSink{endpoint: endpoint, client: client}
+}
+
+func (s *webhookSink) Name() string {
+	return s.endpoint.URL
+}
+
+func (s *webhookSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, event.ID)
+	req.Header.Set(HeaderEventType, event.Type)
+	if len(s.endpoint.Secret) > 0 {
+		timestamp := time.Now().Unix()
+		req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(HeaderSignature, Sign(s.endpoint.Secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("unexpected status %s", resp.Status)
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+		return Permanent(err)
+	}
+	return err
+}
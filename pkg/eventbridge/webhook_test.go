@@ -0,0 +1,75 @@
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"id":"1-1"}`)
+	now := time.Unix(1700000000, 0)
+	header := http.Header{}
+	header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	header.Set(HeaderSignature, Sign(secret, now.Unix(), body))
+	require.NoError(t, VerifySignature(secret, header, body, time.Minute, now.Add(30*time.Second)))
+
+	require.ErrorIs(t, VerifySignature([]byte("other"), header, body, time.Minute, now), ErrInvalidSignature)
+	require.ErrorIs(t, VerifySignature(secret, header, []byte(`{"id":"1-2"}`), time.Minute, now), ErrInvalidSignature)
+	// the replayed requests are rejected
+	require.ErrorIs(t, VerifySignature(secret, header, body, time.Minute, now.Add(2*time.Minute)), ErrInvalidSignature)
+	header.Del(HeaderTimestamp)
+	require.ErrorIs(t, VerifySignature(secret, header, body, time.Minute, now), ErrInvalidSignature)
+}
+
+func TestWebhookSinkRetries(t *testing.T) {
+	secret := []byte("secret")
+	var statuses []int
+	var received []Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, VerifySignature(secret, r.Header, body, time.Minute, time.Now()))
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		if status == http.StatusOK {
+			var event Event
+			require.NoError(t, json.Unmarshal(body, &event))
+			require.Equal(t, event.ID, r.Header.Get(HeaderEventID))
+			received = append(received, event)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	b := &Bridge{config: Config{MaxRetries: 2, RetryBackoff: time.Millisecond}}
+	sink := NewWebhookSink(Endpoint{URL: server.URL, Secret: secret}, server.Client())
+	event := Event{ID: "10-1", Type: EventObjectSealed, Height: 10, BucketName: "bucket", ObjectName: "object"}
+
+	// the 5xx responses are retried
+	statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	require.NoError(t, b.send(context.Background(), sink, event))
+	require.Equal(t, []Event{event}, received)
+
+	// the event is dropped after the retries
+	statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+	require.Error(t, b.send(context.Background(), sink, event))
+	require.Len(t, received, 1)
+
+	// the 4xx responses are not retried
+	statuses = []int{http.StatusBadRequest}
+	require.Error(t, b.send(context.Background(), sink, event))
+	require.Empty(t, statuses)
+	require.NoError(t, b.send(context.Background(), sink, event))
+	require.Len(t, received, 2)
+}
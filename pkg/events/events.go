@@ -0,0 +1,72 @@
+// Package events decodes the typed events emitted by Greenfield transactions, such as
+// storagetypes.EventCreateBucket or storagetypes.EventSealObject, from the tx results,
+// so that callers can read the ids of the resources without parsing the event strings.
+package events
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	// register the typed events of greenfield modules
+	_ "github.com/bnb-chain/greenfield/x/payment/types"
+	_ "github.com/bnb-chain/greenfield/x/permission/types"
+	_ "github.com/bnb-chain/greenfield/x/storage/types"
+	_ "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// ParseEvents decodes the typed events into proto messages, the untyped events (e.g. "message", "transfer")
+// and the events whose types are not registered are skipped
+func ParseEvents(events []abci.Event) ([]proto.Message, error) {
+	typedEvents := make([]proto.Message, 0, len(events))
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		typedEvents = append(typedEvents, typedEvent)
+	}
+	return typedEvents, nil
+}
+
+// ParseTxResponse decodes the typed events of the TxResponse
+func ParseTxResponse(txResponse *sdk.TxResponse) ([]proto.Message, error) {
+	if txResponse == nil {
+		return nil, nil
+	}
+	return ParseEvents(txResponse.Events)
+}
+
+// ParseResultTx decodes the typed events of the tx result returned by WaitForTx
+func ParseResultTx(resultTx *ctypes.ResultTx) ([]proto.Message, error) {
+	if resultTx == nil {
+		return nil, nil
+	}
+	return ParseEvents(resultTx.TxResult.Events)
+}
+
+// FindEvent returns the first event of type T, e.g. FindEvent[*storagetypes.EventCreateBucket](typedEvents)
+func FindEvent[T proto.Message](typedEvents []proto.Message) (T, bool) {
+	for _, typedEvent := range typedEvents {
+		if event, ok := typedEvent.(T); ok {
+			return event, true
+		}
+	}
+	var empty T
+	return empty, false
+}
+
+// FilterEvents returns all the events of type T
+func FilterEvents[T proto.Message](typedEvents []proto.Message) []T {
+	var events []T
+	for _, typedEvent := range typedEvents {
+		if event, ok := typedEvent.(T); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
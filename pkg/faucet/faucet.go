@@ -0,0 +1,70 @@
+// Package faucet provides a client of the Greenfield testnet faucet, which is used by onboarding
+// scripts and integration tests to fund new accounts.
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Client requests the faucet service to fund accounts
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// fundRequest is the request body sent to the faucet
+type fundRequest struct {
+	Address string `json:"address"`
+}
+
+// New returns a faucet client of the endpoint, e.g. the testnet faucet url.
+// The default http client with a 30 seconds timeout is used if httpClient is nil.
+func New(endpoint string, httpClient *http.Client) (*Client, error) {
+	if endpoint == "" {
+		return nil, errors.New("faucet endpoint is empty")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		endpoint:   endpoint,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Fund requests the faucet to send test tokens to the HEX-encoded address and returns the response body of the faucet
+func (c *Client) Fund(ctx context.Context, address string) (string, error) {
+	body, err := json.Marshal(fundRequest{Address: address})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("faucet responds with status code %d: %s", resp.StatusCode, string(respBody))
+	}
+	return string(respBody), nil
+}
@@ -0,0 +1,191 @@
+// Package gnfdhttp serves the objects of a Greenfield bucket over HTTP, e.g. for building simple gateways and static
+// sites on top of the SDK client.
+package gnfdhttp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// ObjectClient is the subset of the object APIs used by FileServer, which is implemented by client.Client
+type ObjectClient interface {
+	HeadObject(ctx context.Context, bucketName, objectName string) (*types.ObjectDetail, error)
+	GetObject(ctx context.Context, bucketName, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error)
+}
+
+// FileServerOptions configures the objects served by FileServer and the headers of the responses
+type FileServerOptions struct {
+	// Prefix is prepended to the path of the request to form the object name, e.g. "site/"
+	Prefix string
+	// IndexObject is the name of the object served for the paths ending with "/", e.g. "index.html", those paths are
+	// not found if it is not set
+	IndexObject string
+	// CacheControl is the Cache-Control header of the responses, e.g. "public, max-age=3600", it is not set if empty
+	CacheControl string
+}
+
+// FileServer returns a handler serving the sealed objects of the bucket by the path of the requests. The responses
+// carry the content type of the object, an ETag of its integrity hash and the Last-Modified of its creation time, and
+// support the range and conditional requests. The payload of a compressed object is served as it is stored, with
+// the Content-Encoding of its compression.
+func FileServer(client ObjectClient, bucketName string, opts FileServerOptions) http.Handler {
+	return &fileServer{client: client, bucketName: bucketName, opts: opts}
+}
+
+type fileServer struct {
+	client     ObjectClient
+	bucketName string
+	opts       FileServerOptions
+}
+
+func (s *fileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	objectName, ok := s.objectName(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	objectDetail, err := s.client.HeadObject(r.Context(), s.bucketName, objectName)
+	if err != nil {
+		if strings.Contains(err.Error(), storageTypes.ErrNoSuchObject.Error()) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, fmt.Sprintf("fail to head object %s: %s", objectName, err.Error()), http.StatusBadGateway)
+		return
+	}
+	objectInfo := objectDetail.ObjectInfo
+	if objectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+		http.NotFound(w, r)
+		return
+	}
+
+	contentType, compression := types.ParseContentEncoding(objectInfo.ContentType)
+	if contentType == "" || contentType == types.ContentDefault {
+		if byExt := mime.TypeByExtension(path.Ext(objectName)); byExt != "" {
+			contentType = byExt
+		} else {
+			contentType = types.ContentDefault
+		}
+	}
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	if compression != types.CompressionNone {
+		header.Set("Content-Encoding", string(compression))
+	}
+	if len(objectInfo.Checksums) > 0 {
+		header.Set("ETag", `"`+hex.EncodeToString(objectInfo.Checksums[0])+`"`)
+	}
+	if s.opts.CacheControl != "" {
+		header.Set("Cache-Control", s.opts.CacheControl)
+	}
+
+	content := &rangeReader{
+		ctx:        r.Context(),
+		client:     s.client,
+		bucketName: s.bucketName,
+		objectName: objectName,
+		size:       int64(objectInfo.PayloadSize),
+	}
+	defer content.Close()
+	http.ServeContent(w, r, objectName, time.Unix(objectInfo.CreateAt, 0), content)
+}
+
+// objectName maps the path of the request to the object name, it returns false if no object is served for the path
+func (s *fileServer) objectName(urlPath string) (string, bool) {
+	name := strings.TrimPrefix(path.Clean("/"+urlPath), "/")
+	if name == "" || strings.HasSuffix(urlPath, "/") {
+		if s.opts.IndexObject == "" {
+			return "", false
+		}
+		if name != "" {
+			name += "/"
+		}
+		name += s.opts.IndexObject
+	}
+	return s.opts.Prefix + name, true
+}
+
+// rangeReader streams the object from the offset of the latest seek by a single range request, so that
+// http.ServeContent reads each range of the response by a request to the SP
+type rangeReader struct {
+	ctx        context.Context
+	client     ObjectClient
+	bucketName string
+	objectName string
+	size       int64
+
+	offset int64
+	body   io.ReadCloser
+}
+
+func (r *rangeReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.body == nil {
+		opts := types.GetObjectOptions{DisableDecompression: true}
+		if r.offset > 0 {
+			if err := opts.SetRange(r.offset, r.size-1); err != nil {
+				return 0, err
+			}
+		}
+		body, _, err := r.client.GetObject(r.ctx, r.bucketName, r.objectName, opts)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *rangeReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("negative position")
+	}
+	if abs != r.offset {
+		r.Close()
+		r.offset = abs
+	}
+	return abs, nil
+}
+
+// Close closes the body of the ongoing range request
+func (r *rangeReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
@@ -0,0 +1,138 @@
+package gnfdhttp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+type fakeObject struct {
+	info    storageTypes.ObjectInfo
+	payload []byte
+}
+
+type fakeClient struct {
+	objects map[string]*fakeObject
+	ranges  []string
+}
+
+func (c *fakeClient) HeadObject(_ context.Context, _, objectName string) (*types.ObjectDetail, error) {
+	object, ok := c.objects[objectName]
+	if !ok {
+		return nil, fmt.Errorf("query failed: %w", storageTypes.ErrNoSuchObject)
+	}
+	return &types.ObjectDetail{ObjectInfo: &object.info}, nil
+}
+
+func (c *fakeClient) GetObject(_ context.Context, _, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error) {
+	c.ranges = append(c.ranges, opts.Range)
+	payload := c.objects[objectName].payload
+	if opts.Range != "" {
+		start, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(opts.Range, "bytes="), fmt.Sprintf("-%d", len(payload)-1)))
+		if err != nil {
+			return nil, types.ObjectStat{}, err
+		}
+		payload = payload[start:]
+	}
+	return io.NopCloser(bytes.NewReader(payload)), types.ObjectStat{}, nil
+}
+
+func newFakeObject(name, contentType, payload string, status storageTypes.ObjectStatus) *fakeObject {
+	return &fakeObject{
+		info: storageTypes.ObjectInfo{
+			ObjectName:   name,
+			ContentType:  contentType,
+			PayloadSize:  uint64(len(payload)),
+			ObjectStatus: status,
+			CreateAt:     1700000000,
+			Checksums:    [][]byte{{0xab, 0xcd}},
+		},
+		payload: []byte(payload),
+	}
+}
+
+func TestFileServer(t *testing.T) {
+	client := &fakeClient{objects: map[string]*fakeObject{
+		"site/index.html": newFakeObject("site/index.html", types.ContentDefault, "<html></html>", storageTypes.OBJECT_STATUS_SEALED),
+		"site/data.txt":   newFakeObject("site/data.txt", "text/plain", "0123456789", storageTypes.OBJECT_STATUS_SEALED),
+		"site/log.txt":    newFakeObject("site/log.txt", "text/plain; gnfd-content-encoding=gzip", "gzipped", storageTypes.OBJECT_STATUS_SEALED),
+		"site/new.txt":    newFakeObject("site/new.txt", "text/plain", "uploading", storageTypes.OBJECT_STATUS_CREATED),
+	}}
+	handler := FileServer(client, "bucket", FileServerOptions{Prefix: "site/", IndexObject: "index.html", CacheControl: "max-age=60"})
+	serve := func(method, target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "/data.txt", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "0123456789", rec.Body.String())
+	require.Equal(t, "text/plain", rec.Header().Get("Content-Type"))
+	require.Equal(t, `"abcd"`, rec.Header().Get("ETag"))
+	require.Equal(t, "max-age=60", rec.Header().Get("Cache-Control"))
+	require.NotEmpty(t, rec.Header().Get("Last-Modified"))
+
+	// the range is streamed from its start
+	client.ranges = nil
+	rec = serve(http.MethodGet, "/data.txt", http.Header{"Range": {"bytes=4-6"}})
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	require.Equal(t, "456", rec.Body.String())
+	require.Equal(t, []string{"bytes=4-9"}, client.ranges)
+
+	rec = serve(http.MethodGet, "/data.txt", http.Header{"If-None-Match": {`"abcd"`}})
+	require.Equal(t, http.StatusNotModified, rec.Code)
+
+	// the payload is not requested by HEAD
+	client.ranges = nil
+	rec = serve(http.MethodHead, "/data.txt", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "10", rec.Header().Get("Content-Length"))
+	require.Empty(t, client.ranges)
+
+	// the content type of the default one is detected by the extension
+	rec = serve(http.MethodGet, "/", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<html></html>", rec.Body.String())
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+
+	rec = serve(http.MethodGet, "/log.txt", nil)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "text/plain", rec.Header().Get("Content-Type"))
+
+	require.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/new.txt", nil).Code)
+	require.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/missing.txt", nil).Code)
+	require.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodPost, "/data.txt", nil).Code)
+}
+
+func TestFileServerObjectName(t *testing.T) {
+	s := &fileServer{opts: FileServerOptions{Prefix: "p/"}}
+	name, ok := s.objectName("/a/../b.txt")
+	require.True(t, ok)
+	require.Equal(t, "p/b.txt", name)
+	_, ok = s.objectName("/dir/")
+	require.False(t, ok)
+
+	s.opts.IndexObject = "index.html"
+	name, ok = s.objectName("/dir/")
+	require.True(t, ok)
+	require.Equal(t, "p/dir/index.html", name)
+	name, ok = s.objectName("/")
+	require.True(t, ok)
+	require.Equal(t, "p/index.html", name)
+}
@@ -0,0 +1,94 @@
+// Package policytemplates provides the vetted statement sets of the common bucket policy patterns, which are passed to
+// PutBucketPolicy with the principal the policy is granted to, e.g.
+//
+//	statements, err := policytemplates.GroupReadWrite()
+//	txHash, err := client.PutBucketPolicy(ctx, bucketName, groupPrincipal, statements, types.PutPolicyOption{})
+//
+// Every template is validated with the same rules as the chain before it is returned.
+package policytemplates
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield/types/common"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+
+	gnfdResource "github.com/bnb-chain/greenfield-go-sdk/pkg/resource"
+)
+
+// PublicRead allows the principal to list the bucket and download all its objects. A policy always has a principal,
+// so the objects can only be downloaded anonymously if the visibility of the bucket is VISIBILITY_TYPE_PUBLIC_READ.
+func PublicRead() ([]*permTypes.Statement, error) {
+	return validate(&permTypes.Statement{
+		Effect:  permTypes.EFFECT_ALLOW,
+		Actions: []permTypes.ActionType{permTypes.ACTION_LIST_OBJECT, permTypes.ACTION_GET_OBJECT},
+	})
+}
+
+// GroupReadWrite allows the principal, usually a group, to list, download, upload, copy and delete all the objects of
+// the bucket, the bucket itself can not be updated or deleted
+func GroupReadWrite() ([]*permTypes.Statement, error) {
+	return validate(&permTypes.Statement{
+		Effect: permTypes.EFFECT_ALLOW,
+		Actions: []permTypes.ActionType{
+			permTypes.ACTION_LIST_OBJECT,
+			permTypes.ACTION_GET_OBJECT,
+			permTypes.ACTION_CREATE_OBJECT,
+			permTypes.ACTION_COPY_OBJECT,
+			permTypes.ACTION_DELETE_OBJECT,
+		},
+	})
+}
+
+// TimeLimitedDownload allows the principal to download the objects of the bucket matching objectPattern until
+// expiresAt, objectPattern supports the wildcards, e.g. "reports/2023-*"
+func TimeLimitedDownload(bucketName, objectPattern string, expiresAt time.Time) ([]*permTypes.Statement, error) {
+	if !expiresAt.After(time.Now()) {
+		return nil, errors.New("the expiration time of the download should be in the future")
+	}
+	grn, err := gnfdResource.NewObjectGRN(bucketName, objectPattern)
+	if err != nil {
+		return nil, err
+	}
+	expiration := expiresAt.UTC()
+	return validate(&permTypes.Statement{
+		Effect:         permTypes.EFFECT_ALLOW,
+		Actions:        []permTypes.ActionType{permTypes.ACTION_GET_OBJECT},
+		Resources:      []string{grn},
+		ExpirationTime: &expiration,
+	})
+}
+
+// UploaderOnly allows the principal to create the objects under the prefix of the bucket, e.g. "uploads/", and
+// nothing else. The size of every object is limited to limitSize bytes if it is not zero.
+func UploaderOnly(bucketName, prefix string, limitSize uint64) ([]*permTypes.Statement, error) {
+	if strings.ContainsAny(prefix, "*?") {
+		return nil, errors.New("the prefix of the uploads should not contain the wildcards")
+	}
+	grn, err := gnfdResource.NewObjectGRN(bucketName, prefix+"*")
+	if err != nil {
+		return nil, err
+	}
+	statement := &permTypes.Statement{
+		Effect:    permTypes.EFFECT_ALLOW,
+		Actions:   []permTypes.ActionType{permTypes.ACTION_CREATE_OBJECT},
+		Resources: []string{grn},
+	}
+	if limitSize != 0 {
+		statement.LimitSize = &common.UInt64Value{Value: limitSize}
+	}
+	return validate(statement)
+}
+
+// validate checks the statements as bucket policy statements with the rules of the chain
+func validate(statements ...*permTypes.Statement) ([]*permTypes.Statement, error) {
+	for _, statement := range statements {
+		if err := statement.ValidateBasic(resource.RESOURCE_TYPE_BUCKET); err != nil {
+			return nil, err
+		}
+	}
+	return statements, nil
+}
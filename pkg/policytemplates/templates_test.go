@@ -0,0 +1,59 @@
+package policytemplates
+
+import (
+	"testing"
+	"time"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicRead(t *testing.T) {
+	statements, err := PublicRead()
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Equal(t, permTypes.EFFECT_ALLOW, statements[0].Effect)
+	require.ElementsMatch(t, []permTypes.ActionType{permTypes.ACTION_LIST_OBJECT, permTypes.ACTION_GET_OBJECT}, statements[0].Actions)
+	require.Empty(t, statements[0].Resources)
+}
+
+func TestGroupReadWrite(t *testing.T) {
+	statements, err := GroupReadWrite()
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Contains(t, statements[0].Actions, permTypes.ACTION_CREATE_OBJECT)
+	require.NotContains(t, statements[0].Actions, permTypes.ACTION_DELETE_BUCKET)
+	require.NotContains(t, statements[0].Actions, permTypes.ACTION_TYPE_ALL)
+}
+
+func TestTimeLimitedDownload(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	statements, err := TimeLimitedDownload("bucket", "reports/2023-*", expiresAt)
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Equal(t, []string{"grn:o::bucket/reports/2023-*"}, statements[0].Resources)
+	require.Equal(t, []permTypes.ActionType{permTypes.ACTION_GET_OBJECT}, statements[0].Actions)
+	require.True(t, statements[0].ExpirationTime.Equal(expiresAt))
+
+	_, err = TimeLimitedDownload("bucket", "report", time.Now().Add(-time.Minute))
+	require.Error(t, err)
+	_, err = TimeLimitedDownload("Bucket", "report", expiresAt)
+	require.Error(t, err)
+}
+
+func TestUploaderOnly(t *testing.T) {
+	statements, err := UploaderOnly("bucket", "uploads/", 1024)
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Equal(t, []string{"grn:o::bucket/uploads/*"}, statements[0].Resources)
+	require.Equal(t, []permTypes.ActionType{permTypes.ACTION_CREATE_OBJECT}, statements[0].Actions)
+	require.Equal(t, uint64(1024), statements[0].LimitSize.GetValue())
+
+	statements, err = UploaderOnly("bucket", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"grn:o::bucket/*"}, statements[0].Resources)
+	require.Nil(t, statements[0].LimitSize)
+
+	_, err = UploaderOnly("bucket", "up*", 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,128 @@
+// Package resource builds and parses the Greenfield resource names (GRN) used as the resources of
+// policy statements, e.g. "grn:b::bucketName", "grn:o::bucketName/objectName" and
+// "grn:g:ownerAddress:groupName". The names are validated with the same rules as the chain, so that
+// a GRN which cannot be parsed by the chain is rejected before the transaction is sent.
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// wildcardChars are the characters treated as wildcards in the names of a GRN
+const wildcardChars = "*?"
+
+// GRN is the parsed form of a Greenfield resource name
+type GRN struct {
+	Type resource.ResourceType
+	// BucketName is set for bucket and object resources
+	BucketName string
+	// ObjectName is set for object resources, it may contain "/"
+	ObjectName string
+	// GroupOwner is the HEX-encoded owner address of group resources
+	GroupOwner string
+	// GroupName is set for group resources
+	GroupName string
+}
+
+// String returns the GRN string which is accepted by the chain
+func (g *GRN) String() string {
+	switch g.Type {
+	case resource.RESOURCE_TYPE_BUCKET:
+		return gnfdTypes.NewBucketGRN(g.BucketName).String()
+	case resource.RESOURCE_TYPE_OBJECT:
+		return gnfdTypes.NewObjectGRN(g.BucketName, g.ObjectName).String()
+	case resource.RESOURCE_TYPE_GROUP:
+		owner, err := sdk.AccAddressFromHexUnsafe(g.GroupOwner)
+		if err != nil {
+			return ""
+		}
+		return gnfdTypes.NewGroupGRN(owner, g.GroupName).String()
+	default:
+		return ""
+	}
+}
+
+// HasWildcard reports whether the names of the GRN contain wildcards
+func (g *GRN) HasWildcard() bool {
+	return strings.ContainsAny(g.BucketName+g.ObjectName+g.GroupName, wildcardChars)
+}
+
+// NewBucketGRN returns the GRN string of the bucket, the bucketName supports wildcards
+func NewBucketGRN(bucketName string) (string, error) {
+	return build(&GRN{Type: resource.RESOURCE_TYPE_BUCKET, BucketName: bucketName})
+}
+
+// NewObjectGRN returns the GRN string of the object, the objectName may contain "/" and both names support wildcards
+func NewObjectGRN(bucketName, objectName string) (string, error) {
+	return build(&GRN{Type: resource.RESOURCE_TYPE_OBJECT, BucketName: bucketName, ObjectName: objectName})
+}
+
+// NewGroupGRN returns the GRN string of the group owned by the HEX-encoded ownerAddr, the groupName supports wildcards
+func NewGroupGRN(ownerAddr, groupName string) (string, error) {
+	return build(&GRN{Type: resource.RESOURCE_TYPE_GROUP, GroupOwner: ownerAddr, GroupName: groupName})
+}
+
+// ParseGRN parses the GRN string, wildcards are allowed in the names
+func ParseGRN(grn string) (*GRN, error) {
+	var parsed gnfdTypes.GRN
+	if err := parsed.ParseFromString(grn, true); err != nil {
+		return nil, err
+	}
+
+	g := &GRN{Type: parsed.ResourceType()}
+	switch g.Type {
+	case resource.RESOURCE_TYPE_BUCKET:
+		g.BucketName = parsed.MustGetBucketName()
+	case resource.RESOURCE_TYPE_OBJECT:
+		g.BucketName, g.ObjectName = parsed.MustGetBucketAndObjectName()
+	case resource.RESOURCE_TYPE_GROUP:
+		owner, groupName := parsed.MustGetGroupOwnerAndAccount()
+		g.GroupOwner, g.GroupName = owner.String(), groupName
+	default:
+		return nil, fmt.Errorf("unknown resource type of grn %s", grn)
+	}
+	return g, nil
+}
+
+// ValidateGRN checks whether the GRN string can be parsed by the chain. The names must be valid
+// bucket, object or group names when wildcards is false.
+func ValidateGRN(grn string, wildcards bool) error {
+	g, err := ParseGRN(grn)
+	if err != nil {
+		return err
+	}
+	if !wildcards && g.HasWildcard() {
+		return fmt.Errorf("wildcards are not allowed in grn %s", grn)
+	}
+	var parsed gnfdTypes.GRN
+	return parsed.ParseFromString(grn, wildcards)
+}
+
+// build validates the names of the GRN and returns the string form of it
+func build(g *GRN) (string, error) {
+	for _, name := range []string{g.BucketName, g.ObjectName, g.GroupName} {
+		if strings.Contains(name, ":") {
+			return "", fmt.Errorf("name %q contains the reserved character ':'", name)
+		}
+	}
+	if g.Type != resource.RESOURCE_TYPE_GROUP && strings.Contains(g.BucketName, "/") {
+		return "", fmt.Errorf("bucket name %q contains the reserved character '/'", g.BucketName)
+	}
+	if g.Type == resource.RESOURCE_TYPE_GROUP {
+		if _, err := sdk.AccAddressFromHexUnsafe(g.GroupOwner); err != nil {
+			return "", fmt.Errorf("invalid group owner %s: %w", g.GroupOwner, err)
+		}
+	}
+
+	grn := g.String()
+	var parsed gnfdTypes.GRN
+	if err := parsed.ParseFromString(grn, g.HasWildcard()); err != nil {
+		return "", err
+	}
+	return grn, nil
+}
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+	"github.com/stretchr/testify/require"
+)
+
+const testOwner = "0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"
+
+func TestNewObjectGRN(t *testing.T) {
+	cases := []struct {
+		name       string
+		bucketName string
+		objectName string
+		expected   string
+		wantErr    bool
+	}{
+		{name: "plain", bucketName: "bucket", objectName: "object", expected: "grn:o::bucket/object"},
+		{name: "nested path", bucketName: "bucket", objectName: "dir/sub/object.txt", expected: "grn:o::bucket/dir/sub/object.txt"},
+		{name: "trailing slash", bucketName: "bucket", objectName: "dir/", expected: "grn:o::bucket/dir/"},
+		{name: "unicode", bucketName: "bucket", objectName: "目录/文件-ü.txt", expected: "grn:o::bucket/目录/文件-ü.txt"},
+		{name: "wildcard", bucketName: "bucket", objectName: "*", expected: "grn:o::bucket/*"},
+		{name: "reserved colon", bucketName: "bucket", objectName: "a:b", wantErr: true},
+		{name: "slash in bucket", bucketName: "buc/ket", objectName: "object", wantErr: true},
+		{name: "double slash", bucketName: "bucket", objectName: "a//b", wantErr: true},
+		{name: "bad path component", bucketName: "bucket", objectName: "a/../b", wantErr: true},
+		{name: "empty object", bucketName: "bucket", objectName: "", wantErr: true},
+		{name: "invalid bucket", bucketName: "Bucket", objectName: "object", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			grn, err := NewObjectGRN(c.bucketName, c.objectName)
+			if c.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, grn)
+
+			parsed, err := ParseGRN(grn)
+			require.NoError(t, err)
+			require.Equal(t, resource.RESOURCE_TYPE_OBJECT, parsed.Type)
+			require.Equal(t, c.bucketName, parsed.BucketName)
+			require.Equal(t, c.objectName, parsed.ObjectName)
+			require.Equal(t, grn, parsed.String())
+		})
+	}
+}
+
+func TestNewBucketGRN(t *testing.T) {
+	grn, err := NewBucketGRN("my-bucket")
+	require.NoError(t, err)
+	require.Equal(t, "grn:b::my-bucket", grn)
+
+	grn, err = NewBucketGRN("my-*")
+	require.NoError(t, err)
+	require.Equal(t, "grn:b::my-*", grn)
+
+	_, err = NewBucketGRN("ab")
+	require.Error(t, err)
+	_, err = NewBucketGRN("my:bucket")
+	require.Error(t, err)
+}
+
+func TestNewGroupGRN(t *testing.T) {
+	grn, err := NewGroupGRN(testOwner, "my group")
+	require.NoError(t, err)
+	require.Equal(t, "grn:g:"+testOwner+":my group", grn)
+
+	parsed, err := ParseGRN(grn)
+	require.NoError(t, err)
+	require.Equal(t, resource.RESOURCE_TYPE_GROUP, parsed.Type)
+	require.Equal(t, testOwner, parsed.GroupOwner)
+	require.Equal(t, "my group", parsed.GroupName)
+
+	_, err = NewGroupGRN("not-an-address", "group")
+	require.Error(t, err)
+	_, err = NewGroupGRN(testOwner, "a:b")
+	require.Error(t, err)
+}
+
+func TestParseGRN(t *testing.T) {
+	for _, grn := range []string{
+		"",
+		"grn:x::bucket",
+		"grn:|::bucket",
+		"grn:b:" + testOwner + ":bucket",
+		"grn:o::bucket",
+		"grn:o::bucket/a:b",
+	} {
+		_, err := ParseGRN(grn)
+		require.Error(t, err, grn)
+	}
+}
+
+func TestValidateGRN(t *testing.T) {
+	require.NoError(t, ValidateGRN("grn:o::bucket/dir/object", false))
+	require.NoError(t, ValidateGRN("grn:o::bucket/*", true))
+	require.Error(t, ValidateGRN("grn:o::bucket/*", false))
+	require.Error(t, ValidateGRN("grn:o::bucket/obj?", false))
+}
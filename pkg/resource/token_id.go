@@ -0,0 +1,51 @@
+package resource
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// The buckets, objects and groups mirrored to BSC are minted as ERC-721 tokens by the bucket, object and group hub
+// contracts, the token id of a resource is its id on Greenfield, encoded as uint256.
+
+// maxTokenIDBits is the bit length of the uint256 token ids of ERC-721
+const maxTokenIDBits = 256
+
+// TokenID returns the ERC-721 token id of the resource id
+func TokenID(id math.Uint) *big.Int {
+	return id.BigInt()
+}
+
+// TokenIDHex returns the token id of the resource id as the 32-byte HEX-encoded uint256 with the 0x prefix, which is
+// the form of the indexed tokenId topic of the Transfer events
+func TokenIDHex(id math.Uint) string {
+	return fmt.Sprintf("0x%064x", id.BigInt())
+}
+
+// ParseTokenID parses the token id in decimal or in HEX with the 0x prefix into the resource id
+func ParseTokenID(tokenID string) (math.Uint, error) {
+	var (
+		value *big.Int
+		ok    bool
+	)
+	if hex := strings.TrimPrefix(strings.TrimPrefix(tokenID, "0x"), "0X"); hex != tokenID {
+		value, ok = new(big.Int).SetString(hex, 16)
+	} else {
+		value, ok = new(big.Int).SetString(tokenID, 10)
+	}
+	if !ok {
+		return math.ZeroUint(), fmt.Errorf("invalid token id %q", tokenID)
+	}
+	return TokenIDFromBig(value)
+}
+
+// TokenIDFromBig converts the ERC-721 token id into the resource id, the token id should be in the range of uint256
+func TokenIDFromBig(tokenID *big.Int) (math.Uint, error) {
+	if tokenID == nil || tokenID.Sign() < 0 || tokenID.BitLen() > maxTokenIDBits {
+		return math.ZeroUint(), fmt.Errorf("token id %v is out of the range of uint256", tokenID)
+	}
+	return math.NewUintFromBigInt(tokenID), nil
+}
@@ -0,0 +1,37 @@
+package resource
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenID(t *testing.T) {
+	id := math.NewUint(1234)
+	require.Equal(t, big.NewInt(1234), TokenID(id))
+	require.Equal(t, "0x00000000000000000000000000000000000000000000000000000000000004d2", TokenIDHex(id))
+
+	for _, s := range []string{"1234", "0x4d2", "0X04D2", TokenIDHex(id)} {
+		parsed, err := ParseTokenID(s)
+		require.NoError(t, err, s)
+		require.True(t, id.Equal(parsed), s)
+	}
+}
+
+func TestParseTokenIDInvalid(t *testing.T) {
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 256)
+	for _, s := range []string{"", "0x", "-1", "12a", "0xzz", tooLarge.String()} {
+		_, err := ParseTokenID(s)
+		require.Error(t, err, s)
+	}
+
+	maxUint256 := new(big.Int).Sub(tooLarge, big.NewInt(1))
+	parsed, err := TokenIDFromBig(maxUint256)
+	require.NoError(t, err)
+	require.Equal(t, maxUint256, TokenID(parsed))
+
+	_, err = TokenIDFromBig(nil)
+	require.Error(t, err)
+}
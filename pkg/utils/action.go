@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+// Action is the readable name of the action type of the policy statements, e.g. "GetObject"
+type Action string
+
+const (
+	ActionUpdateBucketInfo  Action = "UpdateBucketInfo"
+	ActionDeleteBucket      Action = "DeleteBucket"
+	ActionCreateObject      Action = "CreateObject"
+	ActionDeleteObject      Action = "DeleteObject"
+	ActionCopyObject        Action = "CopyObject"
+	ActionGetObject         Action = "GetObject"
+	ActionExecuteObject     Action = "ExecuteObject"
+	ActionListObject        Action = "ListObject"
+	ActionUpdateGroupMember Action = "UpdateGroupMember"
+	ActionDeleteGroup       Action = "DeleteGroup"
+	ActionUpdateObjectInfo  Action = "UpdateObjectInfo"
+	ActionUpdateGroupExtra  Action = "UpdateGroupExtra"
+	// ActionAll allows or denies all the actions on the resource
+	ActionAll Action = "*"
+)
+
+var chainActions = map[Action]permTypes.ActionType{
+	ActionUpdateBucketInfo:  permTypes.ACTION_UPDATE_BUCKET_INFO,
+	ActionDeleteBucket:      permTypes.ACTION_DELETE_BUCKET,
+	ActionCreateObject:      permTypes.ACTION_CREATE_OBJECT,
+	ActionDeleteObject:      permTypes.ACTION_DELETE_OBJECT,
+	ActionCopyObject:        permTypes.ACTION_COPY_OBJECT,
+	ActionGetObject:         permTypes.ACTION_GET_OBJECT,
+	ActionExecuteObject:     permTypes.ACTION_EXECUTE_OBJECT,
+	ActionListObject:        permTypes.ACTION_LIST_OBJECT,
+	ActionUpdateGroupMember: permTypes.ACTION_UPDATE_GROUP_MEMBER,
+	ActionDeleteGroup:       permTypes.ACTION_DELETE_GROUP,
+	ActionUpdateObjectInfo:  permTypes.ACTION_UPDATE_OBJECT_INFO,
+	ActionUpdateGroupExtra:  permTypes.ACTION_UPDATE_GROUP_EXTRA,
+	ActionAll:               permTypes.ACTION_TYPE_ALL,
+}
+
+// IsValid returns whether the action is a readable name or the name of a chain action type, e.g. "ACTION_GET_OBJECT"
+func (a Action) IsValid() bool {
+	_, err := GetChainAction(a)
+	return err == nil
+}
+
+// GetChainAction returns the chain action type of the action. The names of the chain action types are passed
+// through, so the actions added to the chain before the SDK can be used, and the unknown actions are rejected.
+func GetChainAction(a Action) (permTypes.ActionType, error) {
+	if actionType, ok := chainActions[a]; ok {
+		return actionType, nil
+	}
+	if actionType, ok := permTypes.ActionType_value[string(a)]; ok && permTypes.ActionType(actionType) != permTypes.ACTION_UNSPECIFIED {
+		return permTypes.ActionType(actionType), nil
+	}
+	return permTypes.ACTION_UNSPECIFIED, fmt.Errorf("unknown action %q", a)
+}
+
+// GetChainActions converts the actions by GetChainAction
+func GetChainActions(actions []Action) ([]permTypes.ActionType, error) {
+	actionTypes := make([]permTypes.ActionType, 0, len(actions))
+	for _, a := range actions {
+		actionType, err := GetChainAction(a)
+		if err != nil {
+			return nil, err
+		}
+		actionTypes = append(actionTypes, actionType)
+	}
+	return actionTypes, nil
+}
+
+// GetAction returns the readable name of the chain action type, the name of the chain action type is returned if it
+// has no readable name
+func GetAction(actionType permTypes.ActionType) (Action, error) {
+	for a, t := range chainActions {
+		if t == actionType {
+			return a, nil
+		}
+	}
+	if _, ok := permTypes.ActionType_name[int32(actionType)]; ok && actionType != permTypes.ACTION_UNSPECIFIED {
+		return Action(actionType.String()), nil
+	}
+	return "", fmt.Errorf("unknown action type %d", actionType)
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionCoversChainActionTypes(t *testing.T) {
+	for value, name := range permTypes.ActionType_name {
+		actionType := permTypes.ActionType(value)
+		if actionType == permTypes.ACTION_UNSPECIFIED {
+			continue
+		}
+		a, err := GetAction(actionType)
+		require.NoError(t, err, name)
+		require.NotEqual(t, name, string(a), "no readable name for %s", name)
+		got, err := GetChainAction(a)
+		require.NoError(t, err)
+		require.Equal(t, actionType, got)
+	}
+}
+
+func TestGetChainAction(t *testing.T) {
+	actionType, err := GetChainAction(ActionCreateObject)
+	require.NoError(t, err)
+	require.Equal(t, permTypes.ACTION_CREATE_OBJECT, actionType)
+
+	actionType, err = GetChainAction("ACTION_UPDATE_GROUP_EXTRA")
+	require.NoError(t, err)
+	require.Equal(t, permTypes.ACTION_UPDATE_GROUP_EXTRA, actionType)
+
+	for _, a := range []Action{"", "getobject", "ACTION_UNSPECIFIED", "Fly"} {
+		require.False(t, a.IsValid(), a)
+		_, err = GetChainAction(a)
+		require.Error(t, err, a)
+	}
+
+	actionTypes, err := GetChainActions([]Action{ActionAll, ActionUpdateGroupMember})
+	require.NoError(t, err)
+	require.Equal(t, []permTypes.ActionType{permTypes.ACTION_TYPE_ALL, permTypes.ACTION_UPDATE_GROUP_MEMBER}, actionTypes)
+
+	_, err = GetAction(permTypes.ACTION_UNSPECIFIED)
+	require.Error(t, err)
+}
@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+// NameRule indicates the naming rule which a bucket or object name violates
+type NameRule string
+
+const (
+	NameRuleEmpty         NameRule = "empty"
+	NameRuleLength        NameRule = "length"
+	NameRuleCharset       NameRule = "charset"
+	NameRuleIPLike        NameRule = "ip-like"
+	NameRulePrefix        NameRule = "prefix"
+	NameRuleSuffix        NameRule = "suffix"
+	NameRuleAdjacentChars NameRule = "adjacent-chars"
+	NameRulePathComponent NameRule = "path-component"
+	NameRuleEncoding      NameRule = "encoding"
+	NameRuleDoubleSlash   NameRule = "double-slash"
+)
+
+const (
+	MinBucketNameLength = 3
+	MaxBucketNameLength = 63
+	MaxObjectNameLength = 1024
+)
+
+var (
+	bucketNameIPAddress = regexp.MustCompile(`^(\d+\.){3}\d+$`)
+	bucketNameInvalid   = regexp.MustCompile(`[^a-z0-9.\-]`)
+	bucketNameRepeated  = regexp.MustCompile(`[.\-]{2,}`)
+)
+
+// NameError describes which naming rule the bucket or object name fails, the Position is the byte offset
+// of the first offending character in the name, or -1 if the rule is not about a single character
+type NameError struct {
+	// Kind is either "bucket" or "object"
+	Kind     string
+	Name     string
+	Rule     NameRule
+	Position int
+	Message  string
+}
+
+func (e *NameError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error of the chain for the invalid name, so errors.Is works the same as the chain check
+func (e *NameError) Unwrap() error {
+	if e.Kind == "bucket" {
+		return gnfderrors.ErrInvalidBucketName
+	}
+	return gnfderrors.ErrInvalidObjectName
+}
+
+func newNameError(kind, name string, rule NameRule, position int, format string, args ...interface{}) *NameError {
+	return &NameError{Kind: kind, Name: name, Rule: rule, Position: position, Message: fmt.Sprintf(format, args...)}
+}
+
+// VerifyBucketName checks the bucket name with the same rules as the chain and returns a *NameError
+// describing the first rule which fails
+func VerifyBucketName(bucketName string) error {
+	const kind = "bucket"
+	if strings.TrimSpace(bucketName) == "" {
+		return newNameError(kind, bucketName, NameRuleEmpty, -1, "Bucket name cannot be empty")
+	}
+	if len(bucketName) < MinBucketNameLength || len(bucketName) > MaxBucketNameLength {
+		return newNameError(kind, bucketName, NameRuleLength, -1,
+			"Bucket name must be between %d and %d characters long, got %d", MinBucketNameLength, MaxBucketNameLength, len(bucketName))
+	}
+	if bucketNameIPAddress.MatchString(bucketName) {
+		return newNameError(kind, bucketName, NameRuleIPLike, -1, "Bucket name cannot be formatted as an ip address")
+	}
+	if loc := bucketNameInvalid.FindStringIndex(bucketName); loc != nil {
+		r, _ := utf8.DecodeRuneInString(bucketName[loc[0]:])
+		return newNameError(kind, bucketName, NameRuleCharset, loc[0],
+			"Bucket name can only contain lowercase letters, numbers, '.' and '-', found %q at position %d", r, loc[0])
+	}
+	if !isLowerAlphaNum(bucketName[0]) {
+		return newNameError(kind, bucketName, NameRulePrefix, 0, "Bucket name must start with a lowercase letter or number")
+	}
+	if last := len(bucketName) - 1; !isLowerAlphaNum(bucketName[last]) {
+		return newNameError(kind, bucketName, NameRuleSuffix, last, "Bucket name must end with a lowercase letter or number")
+	}
+	for _, seq := range []string{"..", ".-", "-."} {
+		if i := strings.Index(bucketName, seq); i >= 0 {
+			return newNameError(kind, bucketName, NameRuleAdjacentChars, i, "Bucket name cannot contain %q at position %d", seq, i)
+		}
+	}
+	return nil
+}
+
+// VerifyObjectName checks the object name with the same rules as the chain and returns a *NameError
+// describing the first rule which fails
+func VerifyObjectName(objectName string) error {
+	const kind = "object"
+	if strings.TrimSpace(objectName) == "" {
+		return newNameError(kind, objectName, NameRuleEmpty, -1, "Object name cannot be empty")
+	}
+	if len(objectName) > MaxObjectNameLength {
+		return newNameError(kind, objectName, NameRuleLength, -1,
+			"Object name cannot be longer than %d bytes, got %d", MaxObjectNameLength, len(objectName))
+	}
+	offset := 0
+	for _, component := range strings.Split(objectName, "/") {
+		switch strings.TrimSpace(component) {
+		case ".", "..":
+			return newNameError(kind, objectName, NameRulePathComponent, offset,
+				"Object name cannot contain the path component %q at position %d", component, offset)
+		}
+		offset += len(component) + 1
+	}
+	if !utf8.ValidString(objectName) {
+		return newNameError(kind, objectName, NameRuleEncoding, invalidUTF8Index(objectName), "Object name must be a valid UTF-8 string")
+	}
+	if i := strings.Index(objectName, "//"); i >= 0 {
+		return newNameError(kind, objectName, NameRuleDoubleSlash, i, "Object name cannot contain \"//\" at position %d", i)
+	}
+	return nil
+}
+
+// SuggestValidBucketName derives a valid bucket name from the input, e.g. "My_Bucket" becomes "my-bucket".
+// The input is returned as is if it is valid already.
+func SuggestValidBucketName(name string) string {
+	if VerifyBucketName(name) == nil {
+		return name
+	}
+	s := strings.ToLower(strings.TrimSpace(name))
+	s = bucketNameInvalid.ReplaceAllString(s, "-")
+	s = bucketNameRepeated.ReplaceAllStringFunc(s, func(seq string) string {
+		if strings.Trim(seq, ".") == "" {
+			return "."
+		}
+		return "-"
+	})
+	s = strings.Trim(s, ".-")
+	if bucketNameIPAddress.MatchString(s) {
+		s = strings.ReplaceAll(s, ".", "-")
+	}
+	if len(s) > MaxBucketNameLength {
+		s = strings.TrimRight(s[:MaxBucketNameLength], ".-")
+	}
+	if s == "" {
+		return "bucket"
+	}
+	for len(s) < MinBucketNameLength {
+		s += "0"
+	}
+	return s
+}
+
+// SuggestValidObjectName derives a valid object name from the input by dropping the "." and ".." path
+// components and the empty components, and by replacing the invalid UTF-8 bytes.
+// The input is returned as is if it is valid already.
+func SuggestValidObjectName(name string) string {
+	if VerifyObjectName(name) == nil {
+		return name
+	}
+	s := strings.ToValidUTF8(name, "_")
+	components := strings.Split(s, "/")
+	kept := make([]string, 0, len(components))
+	for _, component := range components {
+		switch strings.TrimSpace(component) {
+		case "", ".", "..":
+			continue
+		}
+		kept = append(kept, component)
+	}
+	s = strings.Join(kept, "/")
+	if len(kept) > 0 && strings.HasSuffix(name, "/") {
+		s += "/"
+	}
+	if len(s) > MaxObjectNameLength {
+		s = s[:MaxObjectNameLength]
+		for !utf8.ValidString(s) {
+			s = s[:len(s)-1]
+		}
+		return SuggestValidObjectName(s)
+	}
+	if strings.TrimSpace(s) == "" {
+		return "object"
+	}
+	return s
+}
+
+func isLowerAlphaNum(c byte) bool {
+	return 'a' <= c && c <= 'z' || '0' <= c && c <= '9'
+}
+
+func invalidUTF8Index(s string) int {
+	for i, r := range s {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBucketName(t *testing.T) {
+	cases := map[string]NameRule{
+		"my-bucket.1": "",
+		"":            NameRuleEmpty,
+		"ab":          NameRuleLength,
+		"192.168.1.1": NameRuleIPLike,
+		"My-Bucket":   NameRuleCharset,
+		"my_bucket":   NameRuleCharset,
+		"-bucket":     NameRulePrefix,
+		"bucket.":     NameRuleSuffix,
+		"my..bucket":  NameRuleAdjacentChars,
+		"my.-bucket":  NameRuleAdjacentChars,
+		"bucket-名字":   NameRuleCharset,
+		"a-very-long-bucket-name-which-is-longer-than-sixty-three-characters": NameRuleLength,
+	}
+	for name, rule := range cases {
+		err := VerifyBucketName(name)
+		require.Equal(t, s3util.CheckValidBucketName(name) == nil, err == nil, name)
+		if rule == "" {
+			require.NoError(t, err, name)
+			continue
+		}
+		var nameErr *NameError
+		require.True(t, errors.As(err, &nameErr), name)
+		require.Equal(t, rule, nameErr.Rule, name)
+		require.True(t, errors.Is(err, gnfderrors.ErrInvalidBucketName), name)
+
+		suggested := SuggestValidBucketName(name)
+		require.NoError(t, VerifyBucketName(suggested), "%s -> %s", name, suggested)
+	}
+	require.Equal(t, "my-bucket", SuggestValidBucketName("My_Bucket"))
+}
+
+func TestVerifyObjectName(t *testing.T) {
+	cases := map[string]NameRule{
+		"dir/文件.txt":  "",
+		"dir/":        "",
+		" ":           NameRuleEmpty,
+		"a/../b":      NameRulePathComponent,
+		"./a":         NameRulePathComponent,
+		"a//b":        NameRuleDoubleSlash,
+		"bad\xffname": NameRuleEncoding,
+	}
+	for name, rule := range cases {
+		err := VerifyObjectName(name)
+		require.Equal(t, s3util.CheckValidObjectName(name) == nil, err == nil, name)
+		if rule == "" {
+			require.NoError(t, err, name)
+			continue
+		}
+		var nameErr *NameError
+		require.True(t, errors.As(err, &nameErr), name)
+		require.Equal(t, rule, nameErr.Rule, name)
+		require.True(t, errors.Is(err, gnfderrors.ErrInvalidObjectName), name)
+
+		suggested := SuggestValidObjectName(name)
+		require.NoError(t, VerifyObjectName(suggested), "%q -> %q", name, suggested)
+	}
+	require.Equal(t, "a/b/", SuggestValidObjectName("a/./b//"))
+}
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/greenfield/types/common"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// GnfdPolicy is the JSON format of the policies in the SDK, the enums are encoded by their names, e.g.
+//
+//	{
+//	  "principal": {"type": "PRINCIPAL_TYPE_GNFD_ACCOUNT", "value": "0x..."},
+//	  "statements": [{"effect": "EFFECT_ALLOW", "actions": ["ACTION_GET_OBJECT", "ACTION_LIST_OBJECT"]}]
+//	}
+//
+// The policy returned by GetBucketPolicy, GetObjectPolicy or GetPolicyByID is converted by NewGnfdPolicy, and is
+// re-applied by passing Principal, Statements and ExpirationTime to PutBucketPolicy or PutObjectPolicy.
+type GnfdPolicy struct {
+	// ID, ResourceType and ResourceID are informational, they are ignored when the policy is re-applied
+	ID             string          `json:"id,omitempty"`
+	ResourceType   string          `json:"resource_type,omitempty"`
+	ResourceID     string          `json:"resource_id,omitempty"`
+	Principal      GnfdPrincipal   `json:"principal"`
+	Statements     []GnfdStatement `json:"statements"`
+	ExpirationTime *time.Time      `json:"expiration_time,omitempty"`
+}
+
+// GnfdPrincipal is the principal of GnfdPolicy, the value is the HEX-encoded address of the account or the id of the group
+type GnfdPrincipal struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// GnfdStatement is the statement of GnfdPolicy, LimitSize is not limited if it is zero. The actions are the names of
+// the chain action types, and the readable names of Action are also accepted when the policy is parsed.
+type GnfdStatement struct {
+	Effect         string     `json:"effect"`
+	Actions        []string   `json:"actions"`
+	Resources      []string   `json:"resources,omitempty"`
+	ExpirationTime *time.Time `json:"expiration_time,omitempty"`
+	LimitSize      uint64     `json:"limit_size,omitempty"`
+}
+
+// NewGnfdPolicy converts the policy of the chain into GnfdPolicy
+func NewGnfdPolicy(policy *permTypes.Policy) *GnfdPolicy {
+	p := &GnfdPolicy{
+		ID:             policy.Id.String(),
+		ResourceType:   policy.ResourceType.String(),
+		ResourceID:     policy.ResourceId.String(),
+		Statements:     make([]GnfdStatement, 0, len(policy.Statements)),
+		ExpirationTime: policy.ExpirationTime,
+	}
+	if policy.ResourceType == resource.RESOURCE_TYPE_UNSPECIFIED {
+		p.ResourceType = ""
+	}
+	if policy.Principal != nil {
+		p.Principal = GnfdPrincipal{Type: policy.Principal.Type.String(), Value: policy.Principal.Value}
+	}
+	for _, statement := range policy.Statements {
+		s := GnfdStatement{
+			Effect:         statement.Effect.String(),
+			Actions:        make([]string, 0, len(statement.Actions)),
+			Resources:      statement.Resources,
+			ExpirationTime: statement.ExpirationTime,
+		}
+		for _, action := range statement.Actions {
+			s.Actions = append(s.Actions, action.String())
+		}
+		if statement.LimitSize != nil {
+			s.LimitSize = statement.LimitSize.GetValue()
+		}
+		p.Statements = append(p.Statements, s)
+	}
+	return p
+}
+
+// ParseGnfdPolicy decodes the policy from JSON and checks the names of the enums
+func ParseGnfdPolicy(data []byte) (*GnfdPolicy, error) {
+	p := &GnfdPolicy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	if _, err := p.ToPrincipal(); err != nil {
+		return nil, err
+	}
+	if _, err := p.ToStatements(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// String returns the policy in JSON
+func (p *GnfdPolicy) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+// ToPrincipal returns the marshaled principal which is passed to PutBucketPolicy or PutObjectPolicy
+func (p *GnfdPolicy) ToPrincipal() (types.Principal, error) {
+	principalType, ok := permTypes.PrincipalType_value[p.Principal.Type]
+	if !ok {
+		return "", fmt.Errorf("unknown principal type %q", p.Principal.Type)
+	}
+	principal := &permTypes.Principal{Type: permTypes.PrincipalType(principalType), Value: p.Principal.Value}
+	if err := principal.ValidateBasic(); err != nil {
+		return "", err
+	}
+	principalBytes, err := principal.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return types.Principal(principalBytes), nil
+}
+
+// ToStatements returns the statements which are passed to PutBucketPolicy or PutObjectPolicy
+func (p *GnfdPolicy) ToStatements() ([]*permTypes.Statement, error) {
+	statements := make([]*permTypes.Statement, 0, len(p.Statements))
+	for _, s := range p.Statements {
+		effect, ok := permTypes.Effect_value[s.Effect]
+		if !ok {
+			return nil, fmt.Errorf("unknown effect %q", s.Effect)
+		}
+		statement := &permTypes.Statement{
+			Effect:         permTypes.Effect(effect),
+			Actions:        make([]permTypes.ActionType, 0, len(s.Actions)),
+			Resources:      s.Resources,
+			ExpirationTime: s.ExpirationTime,
+		}
+		for _, name := range s.Actions {
+			action, err := GetChainAction(Action(name))
+			if err != nil {
+				return nil, err
+			}
+			statement.Actions = append(statement.Actions, action)
+		}
+		if s.LimitSize != 0 {
+			statement.LimitSize = &common.UInt64Value{Value: s.LimitSize}
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield/types/common"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGnfdPolicyRoundTrip(t *testing.T) {
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	account := sdk.AccAddress(make([]byte, 20))
+	policy := &permTypes.Policy{
+		Id:           sdkmath.NewUint(7),
+		Principal:    permTypes.NewPrincipalWithAccount(account),
+		ResourceType: resource.RESOURCE_TYPE_BUCKET,
+		ResourceId:   sdkmath.NewUint(42),
+		Statements: []*permTypes.Statement{{
+			Effect:    permTypes.EFFECT_ALLOW,
+			Actions:   []permTypes.ActionType{permTypes.ACTION_GET_OBJECT, permTypes.ACTION_LIST_OBJECT},
+			Resources: []string{"grn:o::bucket/*"},
+			LimitSize: &common.UInt64Value{Value: 1024},
+		}},
+		ExpirationTime: &expiration,
+	}
+
+	p, err := ParseGnfdPolicy([]byte(NewGnfdPolicy(policy).String()))
+	require.NoError(t, err)
+	require.Equal(t, "7", p.ID)
+	require.Equal(t, "RESOURCE_TYPE_BUCKET", p.ResourceType)
+	require.Equal(t, []string{"ACTION_GET_OBJECT", "ACTION_LIST_OBJECT"}, p.Statements[0].Actions)
+	require.True(t, p.ExpirationTime.Equal(expiration))
+
+	statements, err := p.ToStatements()
+	require.NoError(t, err)
+	require.Equal(t, policy.Statements, statements)
+
+	principal, err := p.ToPrincipal()
+	require.NoError(t, err)
+	expected, err := NewPrincipalWithAccount(account)
+	require.NoError(t, err)
+	require.Equal(t, expected, principal)
+}
+
+func TestParseGnfdPolicyUnknownNames(t *testing.T) {
+	_, err := ParseGnfdPolicy([]byte(`{"principal":{"type":"PRINCIPAL_TYPE_GNFD_GROUP","value":"1"},"statements":[{"effect":"EFFECT_ALLOW","actions":["ACTION_FLY"]}]}`))
+	require.ErrorContains(t, err, "ACTION_FLY")
+	_, err = ParseGnfdPolicy([]byte(`{"principal":{"type":"PRINCIPAL_TYPE_GNFD_GROUP","value":"1"},"statements":[{"effect":"ALLOW","actions":[]}]}`))
+	require.ErrorContains(t, err, "ALLOW")
+	_, err = ParseGnfdPolicy([]byte(`{"principal":{"type":"GROUP","value":"1"},"statements":[]}`))
+	require.ErrorContains(t, err, "GROUP")
+}
+
+func TestGnfdPolicyReadableActions(t *testing.T) {
+	p, err := ParseGnfdPolicy([]byte(`{"principal":{"type":"PRINCIPAL_TYPE_GNFD_GROUP","value":"1"},"statements":[{"effect":"EFFECT_ALLOW","actions":["GetObject","ACTION_LIST_OBJECT"]}]}`))
+	require.NoError(t, err)
+	statements, err := p.ToStatements()
+	require.NoError(t, err)
+	require.Equal(t, []permTypes.ActionType{permTypes.ACTION_GET_OBJECT, permTypes.ACTION_LIST_OBJECT}, statements[0].Actions)
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which is refilled at qps tokens per second up to burst tokens, it is safe for
+// concurrent use
+type RateLimiter struct {
+	mu       sync.Mutex
+	qps      float64
+	burst    float64
+	tokens   float64
+	lastTime time.Time
+	now      func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter whose bucket is full, burst is raised to 1 if it is less than 1
+func NewRateLimiter(qps float64, burst int) (*RateLimiter, error) {
+	if qps <= 0 {
+		return nil, fmt.Errorf("the qps of the rate limiter should be positive, got %v", qps)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		qps:      qps,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastTime: time.Now(),
+		now:      time.Now,
+	}, nil
+}
+
+// Wait blocks until a token is taken or ctx is done. The token is reserved before waiting, so the concurrent callers
+// are served in the order they call Wait, and it is given back if ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token, which may leave the bucket in debt, and returns how long to wait until the debt is refilled
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if elapsed := now.Sub(l.lastTime); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.qps
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.lastTime = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.qps * float64(time.Second))
+}
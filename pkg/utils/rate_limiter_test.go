@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Unix(1000, 0)
+	limiter, err := NewRateLimiter(10, 2)
+	require.NoError(t, err)
+	limiter.now = func() time.Time { return now }
+	limiter.lastTime = now
+
+	// the burst is served at once, then the tokens are refilled every 100ms
+	require.Equal(t, time.Duration(0), limiter.reserve())
+	require.Equal(t, time.Duration(0), limiter.reserve())
+	require.Equal(t, 100*time.Millisecond, limiter.reserve())
+	require.Equal(t, 200*time.Millisecond, limiter.reserve())
+
+	// the bucket is refilled up to the burst only
+	now = now.Add(time.Hour)
+	require.Equal(t, time.Duration(0), limiter.reserve())
+	require.Equal(t, time.Duration(0), limiter.reserve())
+	require.Equal(t, 100*time.Millisecond, limiter.reserve())
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	_, err := NewRateLimiter(0, 1)
+	require.Error(t, err)
+
+	limiter, err := NewRateLimiter(1, 0)
+	require.NoError(t, err)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	// the token reserved by the canceled wait is given back
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	limiter.mu.Lock()
+	require.Less(t, limiter.tokens, 0.1)
+	require.Greater(t, limiter.tokens, -0.1)
+	limiter.mu.Unlock()
+}
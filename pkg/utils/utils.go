@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"net/url"
@@ -56,10 +57,10 @@ func GetEndpointURL(endpoint string, secure bool) (*url.URL, error) {
 		scheme = "http"
 	}
 
-	if strings.Contains(endpoint, "http") {
-		s := strings.Split(endpoint, "//")
-		endpoint = s[1]
+	if idx := strings.Index(endpoint, "://"); idx >= 0 {
+		endpoint = endpoint[idx+3:]
 	}
+	endpoint = strings.TrimRight(endpoint, "/")
 	// Construct a secured endpoint URL.
 	endpointURLStr := scheme + "://" + endpoint
 	endpointURL, err := url.Parse(endpointURLStr)
@@ -73,6 +74,34 @@ func GetEndpointURL(endpoint string, secure bool) (*url.URL, error) {
 	return endpointURL, nil
 }
 
+// SplitEndpointHost returns the lower case host name and the port of the endpoint, the endpoint may be with or
+// without the scheme and the trailing slash. The default port of the scheme is returned if the port is absent,
+// and the port is empty if both of the scheme and the port are absent.
+func SplitEndpointHost(endpoint string) (string, string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "//" + endpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	host := strings.ToLower(endpointURL.Hostname())
+	if host == "" {
+		return "", "", errors.New("Endpoint host is empty.")
+	}
+	port := endpointURL.Port()
+	if port == "" {
+		switch strings.ToLower(endpointURL.Scheme) {
+		case "https":
+			port = "443"
+		case "http":
+			port = "80"
+		}
+	}
+	return host, port, nil
+}
+
 // checkEndpointUrl verifies if endpoint url is valid, and return error
 func checkEndpointUrl(endpointURL url.URL) error {
 	if endpointURL == EmptyURL {
@@ -319,3 +348,14 @@ func ReadFull(r io.Reader, buf []byte) (n int, err error) {
 	}
 	return
 }
+
+// DetectContentType returns the content type of the object by the extension of its name,
+// or by sniffing the leading bytes of its payload if the extension is unknown
+func DetectContentType(name string, head []byte) string {
+	if ext := filepath.Ext(name); ext != "" {
+		if contentType := mime.TypeByExtension(ext); contentType != "" {
+			return contentType
+		}
+	}
+	return http.DetectContentType(head)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/bnb-chain/greenfield/sdk/keys"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,8 +36,32 @@ func NewAccountFromPrivateKey(name, privKey string) (*Account, error) {
 	}, nil
 }
 
-func NewAccountFromMnemonic(name, mnemonic string) (*Account, error) {
-	km, err := keys.NewMnemonicKeyManager(mnemonic)
+// HDPathOptions indicates the BIP44 derivation path m/44'/60'/{Account}'/0/{AddressIndex} of the key
+// and the BIP39 passphrase used to derive the account from the mnemonic
+type HDPathOptions struct {
+	Account      uint32
+	AddressIndex uint32
+	Passphrase   string
+}
+
+// NewAccountFromMnemonic derives the account from the mnemonic.
+// The default path m/44'/60'/0'/0/0 is used if the HDPathOptions is not provided.
+func NewAccountFromMnemonic(name, mnemonic string, opts ...HDPathOptions) (*Account, error) {
+	var (
+		km  keys.KeyManager
+		err error
+	)
+	if len(opts) == 0 {
+		km, err = keys.NewMnemonicKeyManager(mnemonic)
+	} else {
+		hdPath := hd.NewParams(44, 60, opts[0].Account, false, opts[0].AddressIndex).String()
+		var derivedPriv []byte
+		derivedPriv, err = hd.EthSecp256k1.Derive()(mnemonic, opts[0].Passphrase, hdPath)
+		if err != nil {
+			return nil, err
+		}
+		km, err = keys.NewPrivateKeyManager(hex.EncodeToString(derivedPriv))
+	}
 	if err != nil {
 		return nil, err
 	}
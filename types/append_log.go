@@ -0,0 +1,36 @@
+package types
+
+import "time"
+
+// AppendLogOptions indicates how AppendLog rolls the log over the immutable objects
+type AppendLogOptions struct {
+	// MaxObjectSize rotates the current object before a write makes it exceed the size, the default value is
+	// DefaultAppendLogMaxObjectSize. A single write larger than the size is still written into one object.
+	MaxObjectSize int64
+	// RotateInterval rotates the current object once it has been open for the interval, it is not rotated by time if
+	// it is zero. The object is always rotated when the UTC date changes.
+	RotateInterval time.Duration
+	// SpoolOptions is used to upload every object and the manifest, the object names are set by AppendLog
+	SpoolOptions SpoolOptions
+	// DeleteOpts is used to delete the previous manifest and its staged copy when the manifest is updated
+	DeleteOpts DeleteObjectOption
+}
+
+// AppendLogManifest is the JSON content of the manifest object of an AppendLog, which lists the rolled objects in
+// the written order
+type AppendLogManifest struct {
+	Version    int               `json:"version"`
+	BucketName string            `json:"bucket_name"`
+	Name       string            `json:"name"`
+	Objects    []AppendLogObject `json:"objects"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// AppendLogObject is a rolled object of an AppendLog
+type AppendLogObject struct {
+	ObjectName string    `json:"object_name"`
+	Size       int64     `json:"size"`
+	OpenedAt   time.Time `json:"opened_at"`
+	ClosedAt   time.Time `json:"closed_at"`
+	TxnHash    string    `json:"txn_hash"`
+}
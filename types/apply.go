@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ApplyAction is the action of a change in the plan
+type ApplyAction string
+
+const (
+	ApplyActionCreate ApplyAction = "create"
+	ApplyActionUpdate ApplyAction = "update"
+	ApplyActionDelete ApplyAction = "delete"
+)
+
+// ApplyResourceKind is the kind of the resource changed
+type ApplyResourceKind string
+
+const (
+	ApplyResourceBucket ApplyResourceKind = "bucket"
+	ApplyResourceGroup  ApplyResourceKind = "group"
+	ApplyResourcePolicy ApplyResourceKind = "policy"
+)
+
+// ApplyChange is a change of a bucket, a group or a policy to reach the desired account state
+type ApplyChange struct {
+	Action ApplyAction       `json:"action"`
+	Kind   ApplyResourceKind `json:"kind"`
+	// Resource describes the changed resource, e.g. `bucket "foo"` or `policy of group "bar" on object "foo/baz"`
+	Resource string `json:"resource"`
+	// Diffs describe the changed fields, e.g. "visibility: VISIBILITY_TYPE_PRIVATE -> VISIBILITY_TYPE_PUBLIC_READ"
+	Diffs []string `json:"diffs,omitempty"`
+
+	// Bucket is the desired bucket of the bucket changes, the fields left empty in the manifest are filled with
+	// the current values
+	Bucket *BucketState `json:"bucket,omitempty"`
+	// Group is the desired group of the group changes
+	Group *GroupState `json:"group,omitempty"`
+	// UpdateExtra indicates whether the extra of the existing group is updated
+	UpdateExtra bool `json:"update_extra,omitempty"`
+	// AddMembers are the HEX-encoded addresses of the members added to the group
+	AddMembers []string `json:"add_members,omitempty"`
+
+	// BucketName and ObjectName identify the resource of the policy changes, ObjectName is empty for the bucket policies
+	BucketName string `json:"bucket_name,omitempty"`
+	ObjectName string `json:"object_name,omitempty"`
+	// Policy is the desired policy of the policy changes, only the principal is used when the policy is deleted
+	Policy *PolicyState `json:"policy,omitempty"`
+}
+
+// ApplyPlan is the changes to reach the desired account state, which is made by PlanAccountState and applied by
+// ApplyAccountState
+type ApplyPlan struct {
+	// Owner is the HEX-encoded address of the account the plan is made for
+	Owner   string         `json:"owner"`
+	Changes []*ApplyChange `json:"changes"`
+}
+
+// HasChanges returns true if the on-chain state differs from the desired state
+func (p *ApplyPlan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// String prints the plan in a human-readable form, e.g.
+//
+//	~ update group "bar"
+//	    extra: "" -> "team"
+//	+ create bucket "foo"
+//	- delete policy of account 0x... on bucket "foo"
+//	Plan: 1 to create, 1 to update, 1 to delete.
+func (p *ApplyPlan) String() string {
+	if !p.HasChanges() {
+		return "No changes. The on-chain state matches the desired state.\n"
+	}
+	var (
+		sb                     strings.Builder
+		creates, updates, dels int
+	)
+	for _, change := range p.Changes {
+		var symbol string
+		switch change.Action {
+		case ApplyActionCreate:
+			symbol = "+"
+			creates++
+		case ApplyActionUpdate:
+			symbol = "~"
+			updates++
+		default:
+			symbol = "-"
+			dels++
+		}
+		fmt.Fprintf(&sb, "%s %s %s\n", symbol, change.Action, change.Resource)
+		for _, diff := range change.Diffs {
+			fmt.Fprintf(&sb, "    %s\n", diff)
+		}
+	}
+	fmt.Fprintf(&sb, "Plan: %d to create, %d to update, %d to delete.\n", creates, updates, dels)
+	return sb.String()
+}
+
+// ApplyResult is the result of ApplyAccountState
+type ApplyResult struct {
+	// Applied is the number of the changes applied
+	Applied  int
+	TxHashes []string
+}
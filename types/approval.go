@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/bnb-chain/greenfield/types/common"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApprovalAction is the action of the approval requested from the primary SP, which is sent as the "action" query
+// parameter of the get-approval requests
+type ApprovalAction string
+
+const (
+	CreateObjectAction  ApprovalAction = "CreateObject"
+	CreateBucketAction  ApprovalAction = "CreateBucket"
+	MigrateBucketAction ApprovalAction = "MigrateBucket"
+)
+
+// GetApproval returns the approval of the primary SP carried by the msg, it returns nil if the msg does not carry one
+func GetApproval(msg sdk.Msg) *common.Approval {
+	switch m := msg.(type) {
+	case *storageTypes.MsgCreateBucket:
+		return m.PrimarySpApproval
+	case *storageTypes.MsgCreateObject:
+		return m.PrimarySpApproval
+	case *storageTypes.MsgMigrateBucket:
+		return m.DstPrimarySpApproval
+	case *storageTypes.MsgCopyObject:
+		return m.DstPrimarySpApproval
+	}
+	return nil
+}
+
+// ApprovalExpiring reports whether the approval would be rejected by the chain if the tx carrying it is broadcast at
+// the height, i.e. it expires within ApprovalExpiryMarginBlocks blocks. The chain rejects the approvals whose
+// ExpiredHeight is below the height of the block including the tx.
+func ApprovalExpiring(approval *common.Approval, height int64) bool {
+	return approval == nil || approval.ExpiredHeight < uint64(height)+ApprovalExpiryMarginBlocks
+}
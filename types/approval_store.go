@@ -0,0 +1,169 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// StoredApproval is the createObject msg signed by the primary SP which is persisted before the broadcast, so that a
+// restarted worker can resume the creation and the upload of the object without asking for a new approval
+type StoredApproval struct {
+	BucketName string `json:"bucket_name"`
+	ObjectName string `json:"object_name"`
+	// SignedMsg is the JSON-encoded createObject msg with the approval of the primary SP
+	SignedMsg []byte `json:"signed_msg"`
+	// TxHash is the hash of the createObject tx, it is empty if the msg has not been broadcast
+	TxHash    string    `json:"tx_hash,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewStoredApproval encodes the signed createObject msg
+func NewStoredApproval(signedMsg *storageTypes.MsgCreateObject, createdAt time.Time) (*StoredApproval, error) {
+	msgBytes, err := storageTypes.ModuleCdc.MarshalJSON(signedMsg)
+	if err != nil {
+		return nil, err
+	}
+	return &StoredApproval{
+		BucketName: signedMsg.BucketName,
+		ObjectName: signedMsg.ObjectName,
+		SignedMsg:  msgBytes,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// Msg decodes the signed createObject msg
+func (a *StoredApproval) Msg() (*storageTypes.MsgCreateObject, error) {
+	var msg storageTypes.MsgCreateObject
+	if err := storageTypes.ModuleCdc.UnmarshalJSON(a.SignedMsg, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// ApprovalStore persists the approvals of the objects being created. The approval of an object is saved before the
+// createObject tx is broadcast and deleted after the payload is uploaded, so the approvals left in the store are the
+// unfinished create+upload flows. The implementations should be safe for concurrent use.
+type ApprovalStore interface {
+	// Save creates or replaces the approval of the object
+	Save(approval *StoredApproval) error
+	// Load returns the approval of the object, or ErrorApprovalNotFound if it is not stored
+	Load(bucketName, objectName string) (*StoredApproval, error)
+	// Delete removes the approval of the object, it succeeds if the approval is not stored
+	Delete(bucketName, objectName string) error
+	// List returns all the stored approvals
+	List() ([]*StoredApproval, error)
+}
+
+// approvalFileSuffix is the suffix of the approval files, the files being written have an extra temp suffix
+const approvalFileSuffix = ".json"
+
+// FileApprovalStore is the ApprovalStore keeping every approval in a JSON file of the directory. The files are
+// written to a temp file and renamed, so an approval is never half written if the process crashes.
+type FileApprovalStore struct {
+	dir   string
+	mutex sync.RWMutex
+}
+
+// NewFileApprovalStore creates the store in dir, the directory is created if it does not exist
+func NewFileApprovalStore(dir string) (*FileApprovalStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileApprovalStore{dir: dir}, nil
+}
+
+// Save writes the approval of the object to its file
+func (s *FileApprovalStore) Save(approval *StoredApproval) error {
+	data, err := json.Marshal(approval)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := s.path(approval.BucketName, approval.ObjectName)
+	tmpFile, err := os.CreateTemp(s.dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err = tmpFile.Write(data); err == nil {
+		err = tmpFile.Sync()
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// Load reads the approval of the object from its file
+func (s *FileApprovalStore) Load(bucketName, objectName string) (*StoredApproval, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.read(s.path(bucketName, objectName))
+}
+
+// Delete removes the file of the approval
+func (s *FileApprovalStore) Delete(bucketName, objectName string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := os.Remove(s.path(bucketName, objectName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List reads all the approval files of the directory
+func (s *FileApprovalStore) List() ([]*StoredApproval, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	approvals := make([]*StoredApproval, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), approvalFileSuffix) {
+			continue
+		}
+		approval, err := s.read(filepath.Join(s.dir, dirEntry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, nil
+}
+
+// path names the file by the hash of the object name, which may contain the characters not allowed in file names
+func (s *FileApprovalStore) path(bucketName, objectName string) string {
+	key := sha256.Sum256([]byte(bucketName + "/" + objectName))
+	return filepath.Join(s.dir, hex.EncodeToString(key[:])+approvalFileSuffix)
+}
+
+func (s *FileApprovalStore) read(path string) (*StoredApproval, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrorApprovalNotFound
+		}
+		return nil, err
+	}
+	var approval StoredApproval
+	if err = json.Unmarshal(data, &approval); err != nil {
+		return nil, err
+	}
+	return &approval, nil
+}
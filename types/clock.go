@@ -0,0 +1,27 @@
+package types
+
+import "time"
+
+// Clock provides the current time of the client, which determines the date and the expiry of the signed requests
+// and authorizations. A FixedClock makes the signatures deterministic, e.g. for the golden tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock of the local system time, it is used if no Clock is configured
+type SystemClock struct{}
+
+// Now returns the local system time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock is the Clock frozen at Time
+type FixedClock struct {
+	Time time.Time
+}
+
+// Now returns the frozen time
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// The formats of the client config parsed by ParseClientConfig
+const (
+	ConfigFormatYAML = "yaml"
+	ConfigFormatTOML = "toml"
+)
+
+// ClientConfig is the configuration of constructing a client, which is loaded from a YAML or TOML file so that the
+// deployments can change the endpoints and the timeouts without recompiling. The secrets are not written in the file,
+// the key is read from an environment variable or a file instead.
+type ClientConfig struct {
+	// Preset is the name of a NetworkPreset, e.g. "testnet", whose chain id and endpoints are used unless they are set
+	Preset      string `json:"preset,omitempty" toml:"preset,omitempty"`
+	ChainID     string `json:"chain_id,omitempty" toml:"chain_id,omitempty"`
+	RpcAddress  string `json:"rpc_address,omitempty" toml:"rpc_address,omitempty"`
+	GrpcAddress string `json:"grpc_address,omitempty" toml:"grpc_address,omitempty"`
+	// SPEndpoints maps the HEX-encoded operator addresses of the SPs to the endpoints overriding their on-chain ones
+	SPEndpoints     map[string]string `json:"sp_endpoints,omitempty" toml:"sp_endpoints,omitempty"`
+	Key             *KeyConfig        `json:"key,omitempty" toml:"key,omitempty"`
+	Timeouts        TimeoutConfig     `json:"timeouts,omitempty" toml:"timeouts,omitempty"`
+	ChainRateLimit  *RateLimitConfig  `json:"chain_rate_limit,omitempty" toml:"chain_rate_limit,omitempty"`
+	Retry           RetryConfig       `json:"retry,omitempty" toml:"retry,omitempty"`
+	Log             LogConfig         `json:"log,omitempty" toml:"log,omitempty"`
+	UserAgentSuffix string            `json:"user_agent_suffix,omitempty" toml:"user_agent_suffix,omitempty"`
+	AppID           string            `json:"app_id,omitempty" toml:"app_id,omitempty"`
+}
+
+// KeyConfig is the source of the key of the default account, exactly one of the sources should be set
+type KeyConfig struct {
+	Name string `json:"name,omitempty" toml:"name,omitempty"`
+	// PrivateKeyEnv and PrivateKeyFile are the environment variable and the file holding the HEX-encoded private key
+	PrivateKeyEnv  string `json:"private_key_env,omitempty" toml:"private_key_env,omitempty"`
+	PrivateKeyFile string `json:"private_key_file,omitempty" toml:"private_key_file,omitempty"`
+	// MnemonicEnv and MnemonicFile are the environment variable and the file holding the mnemonic
+	MnemonicEnv  string `json:"mnemonic_env,omitempty" toml:"mnemonic_env,omitempty"`
+	MnemonicFile string `json:"mnemonic_file,omitempty" toml:"mnemonic_file,omitempty"`
+}
+
+// TimeoutConfig contains the timeouts of the client, the defaults of the client are used for the ones not set
+type TimeoutConfig struct {
+	Dial           Duration `json:"dial,omitempty" toml:"dial,omitempty"`
+	ResponseHeader Duration `json:"response_header,omitempty" toml:"response_header,omitempty"`
+	Request        Duration `json:"request,omitempty" toml:"request,omitempty"`
+	Approval       Duration `json:"approval,omitempty" toml:"approval,omitempty"`
+}
+
+// RetryConfig contains the retry policy of the upload requests, the defaults of the client are used for the ones not set
+type RetryConfig struct {
+	// UploadMaxAttempts is the max number of the attempts of sending an upload request
+	UploadMaxAttempts int `json:"upload_max_attempts,omitempty" toml:"upload_max_attempts,omitempty"`
+	// UploadBackoff is the delay before re-sending the failed upload request, which doubles on every retry
+	UploadBackoff Duration `json:"upload_backoff,omitempty" toml:"upload_backoff,omitempty"`
+}
+
+// RateLimitConfig is the config of RateLimitOptions
+type RateLimitConfig struct {
+	QPS   float64 `json:"qps" toml:"qps"`
+	Burst int     `json:"burst,omitempty" toml:"burst,omitempty"`
+}
+
+// LogConfig contains the options of the logging of the SDK
+type LogConfig struct {
+	// Level is the level of the global zerolog logger, e.g. "debug" or "warn", the level is not changed if it is empty
+	Level string `json:"level,omitempty" toml:"level,omitempty"`
+}
+
+// Duration is a time.Duration written as a string in the config, e.g. "30s" or "1m30s"
+type Duration time.Duration
+
+// UnmarshalText parses the duration by time.ParseDuration
+func (d *Duration) UnmarshalText(text []byte) error {
+	duration, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// MarshalText formats the duration by time.Duration.String
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// ParseClientConfig parses the config in the format, ConfigFormatYAML also accepts JSON, and validates it
+func ParseClientConfig(data []byte, format string) (*ClientConfig, error) {
+	config := &ClientConfig{}
+	var err error
+	switch strings.ToLower(format) {
+	case ConfigFormatYAML, "yml", "json":
+		err = yaml.UnmarshalStrict(data, config)
+	case ConfigFormatTOML:
+		decoder := toml.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(config)
+	default:
+		return nil, fmt.Errorf("%w: unknown config format %q", ErrorInvalidOption, format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse the client config: %v", ErrorInvalidOption, err)
+	}
+	if err = config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate checks the chain is either set by a preset or by the chain id and the rpc address, and at most one
+// source of the key is set
+func (c *ClientConfig) Validate() error {
+	if c.Preset == "" && (c.ChainID == "" || c.RpcAddress == "") {
+		return fmt.Errorf("%w: either the preset or the chain id and the rpc address should be set", ErrorInvalidOption)
+	}
+	if c.ChainRateLimit != nil && c.ChainRateLimit.QPS <= 0 {
+		return fmt.Errorf("%w: the qps of the chain rate limit should be positive", ErrorInvalidOption)
+	}
+	if c.Retry.UploadMaxAttempts < 0 || c.Retry.UploadBackoff < 0 {
+		return fmt.Errorf("%w: the upload retry policy should not be negative", ErrorInvalidOption)
+	}
+	if c.Key != nil {
+		sources := 0
+		for _, source := range []string{c.Key.PrivateKeyEnv, c.Key.PrivateKeyFile, c.Key.MnemonicEnv, c.Key.MnemonicFile} {
+			if source != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("%w: exactly one source of the key should be set", ErrorInvalidOption)
+		}
+	}
+	return nil
+}
+
+// LoadAccount reads the key from its source and returns the account
+func (k *KeyConfig) LoadAccount() (*Account, error) {
+	readSecret := func(env, file string) (string, error) {
+		if env != "" {
+			secret, ok := os.LookupEnv(env)
+			if !ok {
+				return "", fmt.Errorf("the environment variable %s of the key is not set", env)
+			}
+			return strings.TrimSpace(secret), nil
+		}
+		secret, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the key file: %w", err)
+		}
+		return strings.TrimSpace(string(secret)), nil
+	}
+	if k.PrivateKeyEnv != "" || k.PrivateKeyFile != "" {
+		privateKey, err := readSecret(k.PrivateKeyEnv, k.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return NewAccountFromPrivateKey(k.Name, strings.TrimPrefix(privateKey, "0x"))
+	}
+	mnemonic, err := readSecret(k.MnemonicEnv, k.MnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+	return NewAccountFromMnemonic(k.Name, mnemonic)
+}
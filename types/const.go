@@ -32,10 +32,17 @@ const (
 	HTTPHeaderContentSHA256 = "X-Gnfd-Content-Sha256"
 
 	HTTPHeaderUserAddress = "X-Gnfd-User-Address"
+	HTTPHeaderRequestID   = "X-Gnfd-Request-ID"
+	HTTPHeaderAppID       = "X-Gnfd-App-ID"
 
 	ContentTypeXML = "application/xml"
 	ContentDefault = "application/octet-stream"
 
+	// ContentSniffLength is the number of the leading payload bytes used to detect the content type
+	ContentSniffLength = 512
+	// HTTPHeaderUserMetaPrefix is the prefix of the headers carrying user-defined metadata of uploads
+	HTTPHeaderUserMetaPrefix = "X-Gnfd-Meta-"
+
 	// EmptyStringSHA256 is the hex encoded sha256 value of an empty string
 	EmptyStringSHA256       = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
 	Iso8601DateFormatSecond = "2006-01-02T15:04:05Z"
@@ -43,11 +50,8 @@ const (
 	AdminURLPrefix  = "/greenfield/admin"
 	AdminURLVersion = "/v1"
 
-	CreateObjectAction  = "CreateObject"
-	CreateBucketAction  = "CreateBucket"
-	MigrateBucketAction = "MigrateBucket"
-
 	ChallengeUrl           = "challenge"
+	SPStatusUrl            = "status"
 	PrimaryRedundancyIndex = -1
 
 	ContextTimeout   = time.Second * 30
@@ -60,6 +64,12 @@ const (
 	MaxDownloadTryTime   = 3
 	DownloadBackOffDelay = time.Millisecond * 500
 
+	MaxUploadTryTime   = 3
+	UploadBackOffDelay = time.Millisecond * 500
+	// UploadRetryBufferSize is the max size of the payload which is not an io.Seeker to be buffered in memory,
+	// so that the failed upload request can be re-sent
+	UploadRetryBufferSize = 1024 * 1024 * 16
+
 	// MinPartSize - minimum part size 16MiB per object after which
 	// putObject behaves internally as multipart.
 	MinPartSize = 1024 * 1024 * 32
@@ -68,5 +78,79 @@ const (
 	FilePermMode   = os.FileMode(0o664) // Default file permission
 
 	WaitTxContextTimeOut = 1 * time.Second
+	SPHealthCheckTimeout = 5 * time.Second
 	DefaultExpireSeconds = 1000
+
+	// DefaultWaitTxMaxBlocks is the number of the blocks after which WaitForTx checks whether the tx is still pending
+	DefaultWaitTxMaxBlocks = 10
+	// MempoolQueryLimit is the max number of the unconfirmed txs queried from the mempool of the node
+	MempoolQueryLimit = 100
+	// ChecksumManifestVersion is the version of the format of ChecksumManifest
+	ChecksumManifestVersion = 1
+	// SentTxCacheSize is the max number of the txs recently sent by the client which can be resubmitted by hash
+	SentTxCacheSize = 1000
+
+	// AccountActivationAmount is the amount of BNB in wei transferred to activate a new account on chain
+	AccountActivationAmount = 1
+
+	DefaultDeleteObjectsBatchSize  = 100
+	DefaultImportPoliciesBatchSize = 50
+	DefaultApplyBatchSize          = 50
+
+	FileReadBufferSize = 4 * 1024 * 1024 // the buffer size of reading local files
+	WaitSealTimeout    = 5 * time.Minute
+	WaitSealInterval   = 2 * time.Second
+
+	WatchObjectPollInterval = 2 * time.Second
+
+	TrackCrossChainPackageInterval = 3 * time.Second // the default interval of polling the status by TrackCrossChainPackage
+
+	DefaultSettlementWarningWindow = 24 * time.Hour // the default window in which the automatic settlements are warned by GetSettlementSummary
+
+	SyncStatusSampleBlocks        = 100              // the number of the recent blocks whose average interval is used by GetSyncStatus
+	DefaultSyncGuardCheckInterval = 10 * time.Second // the default interval of checking the sync status of the node by the sync guard
+
+	ObjectReaderBlockSize   = 1024 * 1024 // the size of the range requested by ObjectReader at a time
+	ObjectReaderCacheBlocks = 16          // the max number of blocks cached by ObjectReader
+
+	ListObjectsMaxKeys = 1000 // the max number of the objects listed in a page by ListObjects, which is also the default
+
+	ListGroupsDefaultLimit = 50   // the default number of the groups listed in a page by ListGroupsByOwner
+	ListGroupsMaxLimit     = 1000 // the max number of the groups listed in a page by ListGroupsByOwner
+
+	ActivityScanMaxBlocks = 10000 // the max number of the blocks scanned by GetBucketActivity and ListPoliciesByResourceID in a call
+
+	PermissionCheckConcurrency = 16 // the max number of the concurrent permission queries of IsPermissionsAllowed
+	SealStatusQueryConcurrency = 16 // the max number of the concurrent object queries of GetObjectsSealStatus
+
+	// ContentEncodingParam is the parameter appended to the content type of the compressed objects to record the
+	// compression, e.g. "text/plain; gnfd-content-encoding=gzip"
+	ContentEncodingParam = "gnfd-content-encoding"
+
+	DefaultObjectCacheMaxSize = 1024 * 1024 * 1024 // the default max total size of the object disk cache
+
+	ReadRecordPageSize = 1000 // the max number of the read records listed in a request by GetQuotaUsageTrend
+
+	SnapshotListPageSize = 1000 // the number of the resources queried from the chain in a page by the listings at a height
+
+	DefaultDownloadPrefetch = 4 // the default number of the objects opened ahead of the consumer by DownloadPrefix
+
+	DefaultAppendLogMaxObjectSize = 64 * 1024 * 1024        // the default max size of the objects rolled by AppendLog
+	AppendLogManifestVersion      = 1                       // the version of AppendLogManifest
+	AppendLogManifestSuffix       = ".manifest.json"        // the suffix appended to the name of the AppendLog for its manifest object
+	AppendLogStagedManifestSuffix = ".manifest.staged.json" // the suffix of the copy of the manifest kept while the manifest object is replaced
+	AppendLogDateLayout           = "20060102"              // the time layout of the date in the object names of AppendLog
+
+	OverwriteStagedSuffix = ".overwrite-" // the suffix of the staged objects of PutObjectOverwrite, followed by the unix time in nanoseconds
+
+	QuotaMonthLayout = "2006-01" // the time layout of the month of the read quota
+
+	DefaultGrpcMaxCallRecvMsgSize = 64 * 1024 * 1024 // the default max size of the gRPC responses received from the chain node
+
+	ApprovalExpiryMarginBlocks = 3 // the approvals expiring within the blocks are requested again rather than broadcast
+
+	DefaultPresignExpiry = 15 * time.Minute // the default duration for which the uploads signed by PresignPutObject are authorized
+
+	ChainEventSubscriber = "greenfield-go-sdk" // the subscriber of the chain events subscribed over the websocket connection
+	ChainEventBufferSize = 100                 // the number of the chain events of a query buffered before they are dropped
 )
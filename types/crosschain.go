@@ -0,0 +1,66 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CrossChainPackageStatus is the status of a cross-chain package sent from Greenfield to BSC
+type CrossChainPackageStatus string
+
+const (
+	// CrossChainPackagePending means the package is committed on Greenfield and waiting to be relayed or acknowledged
+	CrossChainPackagePending CrossChainPackageStatus = "pending"
+	// CrossChainPackageDelivered means the package has been handled successfully on BSC
+	CrossChainPackageDelivered CrossChainPackageStatus = "delivered"
+	// CrossChainPackageFailed means the package has been handled on BSC but the operation failed, e.g. a mirror is rejected
+	CrossChainPackageFailed CrossChainPackageStatus = "failed"
+	// CrossChainPackageRefunded means the operation failed on BSC and the transferred tokens are refunded on Greenfield
+	CrossChainPackageRefunded CrossChainPackageStatus = "refunded"
+)
+
+// CrossChainPackage is a package sent from Greenfield to BSC, which is stored on Greenfield by the channel and sequence
+type CrossChainPackage struct {
+	ChannelId   uint32
+	Sequence    uint64
+	PackageType sdk.CrossChainPackageType
+	// Timestamp is the unix time in seconds at which the package was sent
+	Timestamp uint64
+	// Payload is the package without the header, which starts with the operation type for the storage channels
+	Payload []byte
+	Status  CrossChainPackageStatus
+}
+
+// DecodeCrossChainPackage decodes the header of the package returned by GetCrossChainPackage
+func DecodeCrossChainPackage(channelId uint32, sequence uint64, rawPackage []byte) (*CrossChainPackage, error) {
+	header, err := sdk.DecodePackageHeader(rawPackage)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cross-chain package %d on channel %d: %w", sequence, channelId, err)
+	}
+	return &CrossChainPackage{
+		ChannelId:   channelId,
+		Sequence:    sequence,
+		PackageType: header.PackageType,
+		Timestamp:   header.Timestamp,
+		Payload:     rawPackage[sdk.GetPackageHeaderLength(header.PackageType):],
+		Status:      CrossChainPackagePending,
+	}, nil
+}
+
+// CrossChainPackageResolver reports the status of a package sent from Greenfield. The acknowledgements relayed back
+// from BSC do not carry the sequence of the acknowledged package, so the packages whose result is not reflected in
+// the Greenfield state, e.g. the transfer-out packages, are resolved by querying BSC.
+type CrossChainPackageResolver interface {
+	// ResolveCrossChainPackage returns CrossChainPackagePending until the package is finalized
+	ResolveCrossChainPackage(ctx context.Context, pkg *CrossChainPackage) (CrossChainPackageStatus, error)
+}
+
+// CrossChainPackageResolverFunc is an adapter to allow the use of ordinary functions as CrossChainPackageResolver
+type CrossChainPackageResolverFunc func(ctx context.Context, pkg *CrossChainPackage) (CrossChainPackageStatus, error)
+
+// ResolveCrossChainPackage calls f(ctx, pkg)
+func (f CrossChainPackageResolverFunc) ResolveCrossChainPackage(ctx context.Context, pkg *CrossChainPackage) (CrossChainPackageStatus, error) {
+	return f(ctx, pkg)
+}
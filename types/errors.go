@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const unknownErr = "unknown error"
@@ -14,18 +16,90 @@ const unknownErr = "unknown error"
 var (
 	ErrorDefaultAccountNotExist = errors.New("Default account of client is not exist ")
 	ErrorProposalIDNotFound     = errors.New("Proposal ID not found ")
+	ErrorSignerPubKeyNotSet     = errors.New("Public key of the signer is not set ")
+	ErrorTxSignerInfoNotFound   = errors.New("Signer info of the tx is not found ")
+	ErrorTxNotSigned            = errors.New("Tx is not signed ")
+	ErrorDeleteProtected        = errors.New("Resource is protected from deletion ")
+	ErrorObjectConflict         = errors.New("Object already exists with different owner or content ")
+	ErrorEventNotFound          = errors.New("Event not found in the transaction ")
+	ErrorSPNotFound             = errors.New("Storage provider not found ")
+	ErrorChecksumMismatch       = errors.New("Checksum of the downloaded payload mismatches ")
+	ErrorReadOnlyClient         = errors.New("Write operation is not allowed by the read-only client ")
+	ErrorInsufficientReadQuota  = errors.New("Remaining read quota of the bucket is insufficient ")
+	ErrorGasPriceExceedsCap     = errors.New("Gas price of the chain exceeds the cap of the fee policy ")
+	ErrorTxNotIncluded          = errors.New("Tx is not included in the chain ")
+	ErrorSentTxNotFound         = errors.New("Tx is not found in the txs recently sent by the client ")
+	ErrorApprovalNotFound       = errors.New("Approval of the object is not found in the store ")
+	ErrorGrantNotEffective      = errors.New("Granted permission is not effective ")
+	ErrorAccountNotFound        = errors.New("Account is not found on chain ")
+	ErrorInsufficientFunds      = errors.New("Balance of the account is insufficient ")
+	ErrorInvalidOption          = errors.New("Option is invalid ")
+	ErrorAccountNotFrozen       = errors.New("Stream record of the account is not frozen ")
+	ErrorNodeLagging            = errors.New("Chain node is lagging behind ")
+	ErrorStateAbsent            = errors.New("State is proven to be absent on chain ")
+	ErrorInvalidSPSignature     = errors.New("Signature of storage provider is invalid ")
+	ErrorRedundancyMismatch     = errors.New("Redundancy params mismatch the chain ")
+	ErrorInvalidAuthorization   = errors.New("Authorization of the request is invalid ")
 )
 
+// TxNotIncludedError is returned by WaitForTx when the tx is neither committed nor pending in the mempool after the
+// blocks are waited, e.g. it is evicted for the low fee or dropped as another tx of the same sequence is committed.
+// It matches ErrorTxNotIncluded by errors.Is.
+type TxNotIncludedError struct {
+	TxHash string
+	// Blocks is the number of the blocks waited for the tx
+	Blocks int64
+}
+
+// Error returns the error msg with the guidance of the resubmission
+func (e *TxNotIncludedError) Error() string {
+	return fmt.Sprintf("tx %s is not included in %d blocks and is not pending in the mempool, it may have been evicted or "+
+		"dropped. Check whether the sequence of the account has been used, and resubmit the tx by ResubmitWithHigherFee if "+
+		"the network is congested", e.TxHash, e.Blocks)
+}
+
+func (e *TxNotIncludedError) Unwrap() error {
+	return ErrorTxNotIncluded
+}
+
+// InsufficientFundsError is returned by the preflight checks when the balance of the fee payer is less than the fee
+// of the tx. It matches ErrorInsufficientFunds by errors.Is.
+type InsufficientFundsError struct {
+	Address  string
+	Required sdk.Coin
+	Balance  sdk.Coin
+}
+
+// Shortfall returns the amount the balance is short of the fee
+func (e *InsufficientFundsError) Shortfall() sdk.Coin {
+	return e.Required.Sub(e.Balance)
+}
+
+// Error returns the error msg with the shortfall
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("the balance %s of account %s is insufficient for the fee %s, short of %s", e.Balance, e.Address,
+		e.Required, e.Shortfall())
+}
+
+func (e *InsufficientFundsError) Unwrap() error {
+	return ErrorInsufficientFunds
+}
+
 // ErrResponse define the information of the error response
 type ErrResponse struct {
 	XMLName    xml.Name `xml:"Error"`
 	Code       string   `xml:"Code"`
 	Message    string   `xml:"Message"`
+	RequestID  string   `xml:"RequestId"`
 	StatusCode int
 }
 
 // Error returns the error msg
 func (r ErrResponse) Error() string {
+	if r.RequestID != "" {
+		return fmt.Sprintf("statusCode %v : code : %s  (Message: %s, RequestID: %s)",
+			r.StatusCode, r.Code, r.Message, r.RequestID)
+	}
 	return fmt.Sprintf("statusCode %v : code : %s  (Message: %s)",
 		r.StatusCode, r.Code, r.Message)
 }
@@ -55,6 +129,7 @@ func ConstructErrResponse(r *http.Response, bucketName, objectName string) error
 			StatusCode: r.StatusCode,
 			Code:       "InternalError",
 			Message:    err.Error(),
+			RequestID:  r.Header.Get(HTTPHeaderRequestID),
 		}
 	}
 	// decode the xml content from response body
@@ -98,6 +173,12 @@ func ConstructErrResponse(r *http.Response, bucketName, objectName string) error
 		}
 	}
 
+	if errResp.RequestID == "" {
+		errResp.RequestID = r.Header.Get(HTTPHeaderRequestID)
+	}
+	if errResp.RequestID == "" && r.Request != nil {
+		errResp.RequestID = r.Request.Header.Get(HTTPHeaderRequestID)
+	}
 	return errResp
 }
 
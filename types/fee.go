@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeePolicy decides the gas price of the transactions whose fee is not set in TxOption, i.e. NoSimulate is not set.
+// The gas limit is always simulated, and the minimum gas price suggested by the chain is used if no field is set.
+type FeePolicy struct {
+	// FixedGasPrice is used as the gas price regardless of the price suggested by the chain if it is set,
+	// e.g. sdk.NewCoin("BNB", sdk.NewInt(5000000000))
+	FixedGasPrice sdk.Coin
+	// SurgeMultiplier multiplies the gas price suggested by the chain if it is greater than 1, e.g. 1.5 pays 50% more
+	// than the minimum price so that the transactions are preferred during a congestion
+	SurgeMultiplier float64
+	// MaxGasPrice caps the gas price, the transaction fails with ErrorGasPriceExceedsCap rather than being sent if the
+	// minimum gas price suggested by the chain exceeds the cap. The gas price is not capped if it is not set.
+	MaxGasPrice sdk.Coin
+}
+
+// GasPrice returns the gas price decided by the policy from the minimum gas price suggested by the chain
+func (p *FeePolicy) GasPrice(minGasPrice sdk.Coin) (sdk.Coin, error) {
+	if p == nil {
+		return minGasPrice, nil
+	}
+	gasPrice := minGasPrice
+	switch {
+	case !p.FixedGasPrice.Amount.IsNil() && p.FixedGasPrice.IsPositive():
+		gasPrice = p.FixedGasPrice
+	case p.SurgeMultiplier > 1:
+		multiplier := sdk.NewDecWithPrec(int64(p.SurgeMultiplier*1e6), 6)
+		gasPrice = sdk.NewCoin(minGasPrice.Denom, sdk.NewDecFromInt(minGasPrice.Amount).Mul(multiplier).Ceil().TruncateInt())
+	}
+
+	if p.MaxGasPrice.Amount.IsNil() || !p.MaxGasPrice.IsPositive() {
+		return gasPrice, nil
+	}
+	if p.MaxGasPrice.Denom != minGasPrice.Denom {
+		return sdk.Coin{}, fmt.Errorf("the denom of the max gas price %s differs from the gas price %s of the chain", p.MaxGasPrice.Denom, minGasPrice.Denom)
+	}
+	if minGasPrice.Amount.GT(p.MaxGasPrice.Amount) {
+		return sdk.Coin{}, fmt.Errorf("%w: %s > %s", ErrorGasPriceExceedsCap, minGasPrice, p.MaxGasPrice)
+	}
+	if gasPrice.Amount.GT(p.MaxGasPrice.Amount) {
+		gasPrice = p.MaxGasPrice
+	}
+	return gasPrice, nil
+}
+
+// GasPriceInfo is the current gas price of the chain and the gas params of the transactions
+type GasPriceInfo struct {
+	// MinGasPrice is the minimum gas price accepted by the connected node
+	MinGasPrice sdk.Coin
+	// GasPrice is the gas price decided by the fee policy of the client, it equals MinGasPrice if no policy is set
+	GasPrice sdk.Coin
+	// MaxTxSize is the max size in bytes of a transaction
+	MaxTxSize uint64
+	// MinGasPerByte is the min gas paid for every byte of a transaction
+	MinGasPerByte uint64
+}
@@ -0,0 +1,26 @@
+package types
+
+// HashProgress is the progress of computing the hash roots of a payload
+type HashProgress struct {
+	// HashedBytes is the number of the bytes hashed so far
+	HashedBytes int64
+	// TotalBytes is the size of the payload, it is zero if the size is unknown
+	TotalBytes int64
+	// HashedSegments is the number of the segments hashed so far
+	HashedSegments int
+}
+
+// HashProgressFunc is called after every segment is hashed, the calls are serialized and HashedBytes never decreases
+type HashProgressFunc func(progress HashProgress)
+
+// HashMemoryBound returns the approximate max memory in bytes held by computing the hash roots with at most
+// bufferedSegments segments in flight. Every segment in flight holds its buffer of segmentSize bytes and its
+// erasure-coded pieces of segmentSize*(dataShards+parityShards)/dataShards bytes, e.g. about 40MB per segment for
+// the segment size of 16MB with 4 data shards and 2 parity shards.
+func HashMemoryBound(segmentSize uint64, dataShards, parityShards uint32, bufferedSegments int) int64 {
+	if dataShards == 0 || bufferedSegments <= 0 {
+		return 0
+	}
+	pieces := segmentSize * uint64(dataShards+parityShards) / uint64(dataShards)
+	return int64(segmentSize+pieces) * int64(bufferedSegments)
+}
@@ -0,0 +1,40 @@
+package types
+
+import "time"
+
+// DefaultHedgedMethods are the latency-sensitive chain queries hedged by a HedgingPolicy without Methods, which are
+// made by the SPs and the client to authorize the downloads
+var DefaultHedgedMethods = []string{
+	"/greenfield.storage.Query/HeadBucket",
+	"/greenfield.storage.Query/HeadBucketById",
+	"/greenfield.storage.Query/HeadObject",
+	"/greenfield.storage.Query/HeadObjectById",
+	"/greenfield.storage.Query/VerifyPermission",
+}
+
+// HedgingPolicy sends a chain query to a secondary gRPC endpoint as well if the primary endpoint does not respond in
+// the delay, and takes the first successful response. The other query is canceled once a response is taken.
+type HedgingPolicy struct {
+	// GrpcAddress is the gRPC address of the secondary chain node, it is dialed with the same options as the primary one
+	GrpcAddress string
+	// Delay is how long to wait for the primary endpoint before hedging, the query is sent to both endpoints at once
+	// if it is not set
+	Delay time.Duration
+	// Methods are the full gRPC method names of the hedged queries, e.g. "/greenfield.storage.Query/HeadObject",
+	// DefaultHedgedMethods is used if it is empty. Only the queries should be hedged, not the broadcasts of the txs.
+	Methods []string
+}
+
+// Hedged returns whether the gRPC method is hedged by the policy
+func (p *HedgingPolicy) Hedged(method string) bool {
+	methods := p.Methods
+	if len(methods) == 0 {
+		methods = DefaultHedgedMethods
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// InventoryCSVHeader is the header of the CSV inventory written by WriteInventory
+var InventoryCSVHeader = []string{"name", "size", "checksum", "created", "status"}
+
+// InventoryOptions contains the options of listing the objects of an inventory
+type InventoryOptions struct {
+	// Prefix limits the inventory to the objects whose names begin with it
+	Prefix string
+	// Height is the block height of the chain state to list, the latest height is used if it is 0
+	Height int64
+}
+
+// InventoryDestination is where ExportInventory puts the inventory, either the local file or the object, which is
+// created by the default account
+type InventoryDestination struct {
+	FilePath   string
+	BucketName string
+	ObjectName string
+	// PutOpts are the options of creating the object, the content type is "text/csv" if it is not set
+	PutOpts PutObjectFromFileOptions
+}
+
+// InventoryReport summarizes an inventory
+type InventoryReport struct {
+	BucketName string
+	// Height is the block height of the chain state listed by the inventory
+	Height      int64
+	ObjectCount int64
+	TotalSize   uint64
+	// TxnHash is the hash of the createObject txn of the inventory object, it is empty for the local files
+	TxnHash string
+}
+
+// InventoryRecord returns the CSV record of the object in the order of InventoryCSVHeader, the checksum is the
+// HEX-encoded integrity hash of the primary SP and the creation time is formatted in RFC 3339
+func InventoryRecord(objectInfo *storageTypes.ObjectInfo) []string {
+	var checksum string
+	if len(objectInfo.Checksums) > 0 {
+		checksum = hex.EncodeToString(objectInfo.Checksums[0])
+	}
+	return []string{
+		objectInfo.ObjectName,
+		strconv.FormatUint(objectInfo.PayloadSize, 10),
+		checksum,
+		time.Unix(objectInfo.CreateAt, 0).UTC().Format(time.RFC3339),
+		objectInfo.ObjectStatus.String(),
+	}
+}
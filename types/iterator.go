@@ -0,0 +1,89 @@
+package types
+
+// PageFetcher fetches the next page of a listing. It returns the items of the page and
+// whether more pages are available after it.
+type PageFetcher[T any] func() (items []T, hasMore bool, err error)
+
+// Iterator lazily walks through a paginated listing, fetching a new page from the
+// underlying API only when the items of the current page have been consumed.
+//
+//	iter := client.ListObjectsIterator(ctx, bucketName, types.ListObjectsOptions{})
+//	defer iter.Close()
+//	for iter.Next() {
+//		object := iter.Value()
+//		...
+//	}
+//	if err := iter.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch   PageFetcher[T]
+	page    []T
+	index   int
+	current T
+	hasMore bool
+	closed  bool
+	err     error
+	onClose func() error
+}
+
+// NewIterator returns an Iterator which calls fetch each time a new page is needed
+func NewIterator[T any](fetch PageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch:   fetch,
+		hasMore: true,
+	}
+}
+
+// NewIteratorWithCloser returns an Iterator like NewIterator, onClose is called once when the iterator is closed to
+// release the resources held by the fetcher, e.g. the background goroutines
+func NewIteratorWithCloser[T any](fetch PageFetcher[T], onClose func() error) *Iterator[T] {
+	it := NewIterator(fetch)
+	it.onClose = onClose
+	return it
+}
+
+// Next advances the iterator to the next item, fetching a new page if needed.
+// It returns false when the listing is exhausted, an error occurs or the iterator is closed.
+func (it *Iterator[T]) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+	for it.index >= len(it.page) {
+		if !it.hasMore {
+			return false
+		}
+		page, hasMore, err := it.fetch()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index, it.hasMore = page, 0, hasMore
+	}
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the item the iterator currently points to
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close releases the fetched pages and stops any further fetching
+func (it *Iterator[T]) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+	it.page = nil
+	if it.onClose != nil {
+		return it.onClose()
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import "time"
+
+// LightClientOptions enables the verified queries of the client, which request the merkle proofs of the state from
+// the chain node and verify them against the headers verified by a light client, rather than trusting the node
+type LightClientOptions struct {
+	// TrustedHeight and TrustedHash identify a header obtained from a trusted source, e.g. another node operated by
+	// the application, which is the root of trust of the light client
+	TrustedHeight int64
+	TrustedHash   []byte
+	// TrustPeriod is how long a verified header is trusted, it should be significantly less than the unbonding period
+	TrustPeriod time.Duration
+	// Witnesses are the rpc endpoints of the other nodes which cross-check the headers of the node the client is
+	// connected to, at least one is required
+	Witnesses []string
+}
@@ -6,7 +6,9 @@ import (
 	storageType "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
-// QuotaInfo indicates the quota info of bucket
+// QuotaInfo is the read quota of the bucket reported by the primary SP.
+//
+// Deprecated: the ReadQuotaSize is the charged quota cached by the SP, use BucketQuotaSummary instead.
 type QuotaInfo struct {
 	XMLName             xml.Name `xml:"GetReadQuotaResult"`
 	Version             string   `xml:"version,attr"`
@@ -75,6 +77,13 @@ type ListGroupsResult struct {
 	Count int64 `json:"count,string"`
 }
 
+// ListGroupsByOwnerResult is a page of the groups owned by an account
+type ListGroupsByOwnerResult struct {
+	Groups []*GroupMeta `json:"groups"`
+	// NextStartAfter is the StartAfter of the next page, it is empty if all the groups have been listed
+	NextStartAfter string `json:"-"`
+}
+
 // ObjectMeta is the structure for metadata service user object
 type ObjectMeta struct {
 	// object_info defines the information of the object.
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ChecksumManifest is the hash tree of an object, from which the on-chain checksums are derived. It is encoded in
+// JSON with the hashes in HEX, so the downloaded payload can be verified offline without the SDK:
+//   - the payload is split into the segments of SegmentSize, the last segment may be shorter
+//   - SegmentHashes[j] is the SHA-256 of segment j, and Checksums[0] is the SHA-256 of the concatenated SegmentHashes
+//   - every segment is erasure-coded into DataChunkNum+ParityChunkNum pieces by Reed-Solomon, PieceHashes[i][j] is
+//     the SHA-256 of piece i of segment j, and Checksums[i+1] is the SHA-256 of the concatenated PieceHashes[i]
+type ChecksumManifest struct {
+	Version       int    `json:"version"`
+	HashAlgorithm string `json:"hash_algorithm"`
+
+	BucketName     string `json:"bucket_name"`
+	ObjectName     string `json:"object_name"`
+	ObjectID       string `json:"object_id"`
+	PayloadSize    uint64 `json:"payload_size"`
+	RedundancyType string `json:"redundancy_type"`
+	SegmentSize    uint64 `json:"segment_size"`
+	DataChunkNum   uint32 `json:"data_chunk_num"`
+	ParityChunkNum uint32 `json:"parity_chunk_num"`
+
+	// Checksums are the on-chain checksums of the object
+	Checksums     []string   `json:"checksums"`
+	SegmentHashes []string   `json:"segment_hashes"`
+	PieceHashes   [][]string `json:"piece_hashes"`
+}
+
+// Verify checks that the checksums are the roots of the segment hashes and the piece hashes
+func (m *ChecksumManifest) Verify() error {
+	if len(m.Checksums) != len(m.PieceHashes)+1 {
+		return fmt.Errorf("%w: %d checksums for %d piece hash lists", ErrorChecksumMismatch, len(m.Checksums), len(m.PieceHashes))
+	}
+	lists := append([][]string{m.SegmentHashes}, m.PieceHashes...)
+	for i, list := range lists {
+		root, err := hashRoot(list)
+		if err != nil {
+			return err
+		}
+		checksum, err := hex.DecodeString(m.Checksums[i])
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root, checksum) {
+			return fmt.Errorf("%w: checksum %d of object %s", ErrorChecksumMismatch, i, m.ObjectName)
+		}
+	}
+	return nil
+}
+
+// WriteTo writes the manifest in the indented JSON
+func (m *ChecksumManifest) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
+
+// hashRoot returns the SHA-256 of the concatenated HEX-encoded hashes
+func hashRoot(hexHashes []string) ([]byte, error) {
+	hash := sha256.New()
+	for _, hexHash := range hexHashes {
+		b, err := hex.DecodeString(hexHash)
+		if err != nil {
+			return nil, err
+		}
+		hash.Write(b)
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield/types/resource"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// MirrorStatus is the mirror status of a bucket, object or group, which is read from its ERC-721 metadata on chain
+type MirrorStatus struct {
+	ResourceType resource.ResourceType
+	// TokenID is the ERC-721 token id of the resource on BSC, which equals the resource id
+	TokenID math.Uint
+	// Name is the name of the bucket, object or group
+	Name       string
+	SourceType storageTypes.SourceType
+	// Attributes are the NFT attributes of the resource, keyed by the trait type, e.g. "Owner"
+	Attributes map[string]string
+}
+
+// Mirrored returns whether the resource has been mirrored to BSC as an NFT
+func (s *MirrorStatus) Mirrored() bool {
+	return s.SourceType == storageTypes.SOURCE_TYPE_BSC_CROSS_CHAIN
+}
+
+// Pending returns whether the mirror of the resource is waiting for the ack package from BSC
+func (s *MirrorStatus) Pending() bool {
+	return s.SourceType == storageTypes.SOURCE_TYPE_MIRROR_PENDING
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignatureCollector gathers the partial signatures of the members of a multisig account
+// for the tx built by NewMultisigTx. It is safe for concurrent use.
+type SignatureCollector struct {
+	UnsignedTx *UnsignedTx
+
+	mtx      sync.Mutex
+	pubKey   multisig.PubKey
+	multiSig *signing.MultiSignatureData
+}
+
+// NewSignatureCollector returns a collector of the unsigned tx sent by the multisig account of pubKey
+func NewSignatureCollector(unsignedTx *UnsignedTx, pubKey multisig.PubKey) *SignatureCollector {
+	return &SignatureCollector{
+		UnsignedTx: unsignedTx,
+		pubKey:     pubKey,
+		multiSig:   multisig.NewMultisig(len(pubKey.GetPubKeys())),
+	}
+}
+
+// SignBytes returns the digest that each member of the multisig account needs to sign
+func (s *SignatureCollector) SignBytes() []byte {
+	return s.UnsignedTx.SignBytes
+}
+
+// PubKey returns the public key of the multisig account
+func (s *SignatureCollector) PubKey() multisig.PubKey {
+	return s.pubKey
+}
+
+// AddSignature adds the signature of the member of the multisig account, the signature of a member
+// which has already signed is replaced
+func (s *SignatureCollector) AddSignature(memberPubKey cryptotypes.PubKey, signature []byte) error {
+	if memberPubKey == nil {
+		return ErrorSignerPubKeyNotSet
+	}
+	if len(signature) == 0 {
+		return errors.New("signature is empty")
+	}
+	sigData := &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+		Signature: signature,
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if err := multisig.AddSignatureFromPubKey(s.multiSig, sigData, memberPubKey, s.pubKey.GetPubKeys()); err != nil {
+		return fmt.Errorf("failed to add the signature of %s: %w", memberPubKey.Address().String(), err)
+	}
+	return nil
+}
+
+// SignatureCount returns the number of the collected signatures
+func (s *SignatureCollector) SignatureCount() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return len(s.multiSig.Signatures)
+}
+
+// IsComplete returns whether the collected signatures reach the threshold of the multisig account
+func (s *SignatureCollector) IsComplete() bool {
+	return s.SignatureCount() >= int(s.pubKey.GetThreshold())
+}
+
+// MultiSignatureData returns a copy of the multisig signature assembled from the collected signatures
+func (s *SignatureCollector) MultiSignatureData() *signing.MultiSignatureData {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	multiSig := &signing.MultiSignatureData{
+		BitArray:   s.multiSig.BitArray.Copy(),
+		Signatures: make([]signing.SignatureData, len(s.multiSig.Signatures)),
+	}
+	copy(multiSig.Signatures, s.multiSig.Signatures)
+	return multiSig
+}
@@ -56,6 +56,7 @@ type GrantDepositForStorageProviderOptions struct {
 
 type DeleteBucketOption struct {
 	TxOpts *gnfdsdktypes.TxOption
+	Force  bool // delete the bucket even if it is protected by the delete protection patterns of the client
 }
 
 type UpdatePaymentOption struct {
@@ -89,6 +90,24 @@ type UpdateVisibilityOption struct {
 
 type DeleteObjectOption struct {
 	TxOpts *gnfdsdktypes.TxOption
+	Force  bool // delete the object even if it is protected by the delete protection patterns of the client
+}
+
+// DeleteObjectsOptions indicates the options of deleting objects in batched transactions
+type DeleteObjectsOptions struct {
+	TxOpts          *gnfdsdktypes.TxOption
+	BatchSize       int              // the max number of objects deleted in one transaction, the default value is 100
+	BatchInterval   time.Duration    // the interval between two batched transactions, which bounds the deleting rate
+	DryRun          bool             // only return the objects to be deleted without sending any transaction
+	EndPointOptions *EndPointOptions // the endpoint used to list the objects matching the prefix
+	Force           bool             // delete the objects even if they are protected by the delete protection patterns of the client
+}
+
+// DownloadPrefixOptions indicates the options of downloading the objects under a prefix
+type DownloadPrefixOptions struct {
+	Prefetch         int              // the max number of the objects opened ahead of the consumer, the default value is DefaultDownloadPrefetch
+	GetObjectOptions GetObjectOptions // the options of downloading every object
+	EndPointOptions  *EndPointOptions // the endpoint used to list the objects matching the prefix
 }
 
 type DeleteGroupOption struct {
@@ -104,6 +123,20 @@ type CreateObjectOptions struct {
 	IsReplicaType       bool // indicates whether the object use REDUNDANCY_REPLICA_TYPE
 	IsAsyncMode         bool // indicate whether to create the object in asynchronous mode
 	IsSerialComputeMode bool // indicate whether to compute integrity hash in serial way or parallel way when creating object
+	// DisableContentTypeSniffing indicates whether to use the default content type if ContentType is not set,
+	// rather than detecting it from the object name extension and the payload
+	DisableContentTypeSniffing bool
+	// Idempotent indicates whether to return the existing object rather than creating it again or failing with an
+	// ambiguous error, if the object with the same owner and checksums has been created, e.g. by a previous attempt
+	// whose broadcast timed out
+	Idempotent bool
+
+	// Compression compresses the payload before computing the checksums, the same Compression must be set in the
+	// PutObjectOptions when uploading the payload. The compression is recorded in the content type of the object.
+	Compression CompressionType
+	// OnHashProgress reports the progress of computing the checksums of the payload, which may take minutes for the
+	// large payloads. The segments are hashed in parallel if it is set, regardless of IsSerialComputeMode.
+	OnHashProgress HashProgressFunc
 }
 
 // CreateGroupOptions  indicates the meta to construct createGroup msg
@@ -117,6 +150,19 @@ type UpdateGroupMemberOption struct {
 	TxOpts *gnfdsdktypes.TxOption
 }
 
+// GroupMemberToAdd is a member added to the group by UpdateGroupMembers
+type GroupMemberToAdd struct {
+	Address string // the HEX-encoded address of the member
+	// ExpirationTime is the time at which the membership expires, the membership never expires if it is zero
+	ExpirationTime time.Time
+}
+
+// GroupMemberUpdate indicates the members added to and removed from the group in one transaction
+type GroupMemberUpdate struct {
+	Add    []GroupMemberToAdd
+	Remove []string // the HEX-encoded addresses of the members to be removed
+}
+
 type LeaveGroupOption struct {
 	TxOpts *gnfdsdktypes.TxOption
 }
@@ -126,7 +172,10 @@ type RenewGroupMemberOption struct {
 	TxOpts *gnfdsdktypes.TxOption
 }
 
-// ComputeHashOptions indicates the metadata of redundancy strategy
+// ComputeHashOptions overrides the redundancy params of the storage params on chain, e.g. for the private deployments
+// with custom params. The zero fields are taken from the chain. The overrides are validated against the chain params
+// whenever they can be queried, and are only used as they are if the chain is unreachable and all the fields are set,
+// e.g. computing the hash roots offline.
 type ComputeHashOptions struct {
 	SegmentSize  uint64
 	DataShards   uint32
@@ -164,10 +213,65 @@ type ListObjectsOptions struct {
 	Prefix string
 
 	// MaxKeys defines the maximum number of keys returned to the response body.
-	// If not specified, the default value is 50.
-	// The maximum limit for returning objects is 1000
+	// If not specified, the default value is ListObjectsMaxKeys, which is also the maximum limit.
 	MaxKeys         uint64
 	EndPointOptions *EndPointOptions
+
+	// The following filters are applied to each page of the listing on the client side, so that a page may contain
+	// fewer objects than MaxKeys even if the listing is truncated.
+
+	// CreatedAfter and CreatedBefore limit the creation time of the objects, the zero values disable the filters.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// ObjectStatuses limits the objects to the specified statuses, e.g. OBJECT_STATUS_CREATED or OBJECT_STATUS_SEALED.
+	ObjectStatuses []storageTypes.ObjectStatus
+	// MinSize and MaxSize limit the payload size of the objects, MaxSize is ignored if it is zero.
+	MinSize uint64
+	MaxSize uint64
+	// ContentTypes limits the objects to the specified content types.
+	ContentTypes []string
+}
+
+// MatchObject returns whether the object matches the filters of the options, the object without info is always matched
+func (o ListObjectsOptions) MatchObject(objectInfo *ObjectInfo) bool {
+	if objectInfo == nil {
+		return true
+	}
+	createAt := time.Unix(objectInfo.CreateAt, 0)
+	if !o.CreatedAfter.IsZero() && !createAt.After(o.CreatedAfter) {
+		return false
+	}
+	if !o.CreatedBefore.IsZero() && !createAt.Before(o.CreatedBefore) {
+		return false
+	}
+	if objectInfo.PayloadSize < o.MinSize || (o.MaxSize > 0 && objectInfo.PayloadSize > o.MaxSize) {
+		return false
+	}
+	if len(o.ObjectStatuses) > 0 {
+		matched := false
+		for _, status := range o.ObjectStatuses {
+			if objectInfo.ObjectStatus == status {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(o.ContentTypes) > 0 {
+		matched := false
+		for _, contentType := range o.ContentTypes {
+			if objectInfo.ContentType == contentType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
 }
 
 type PutPolicyOption struct {
@@ -199,6 +303,100 @@ type PutObjectOptions struct {
 	TxnHash          string
 	DisableResumable bool
 	PartSize         uint64
+	// DisableContentTypeSniffing indicates whether to use the default content type if ContentType is not set,
+	// rather than detecting it from the object name extension and the payload
+	DisableContentTypeSniffing bool
+	// UserMetadata is the user-defined metadata attached to the upload requests as X-Gnfd-Meta-* headers
+	UserMetadata map[string]string
+	// Timeout is the total timeout of each upload request, which overrides the RequestTimeout of the client
+	Timeout time.Duration
+	// Compression compresses the payload before uploading, it must be the same as the Compression of the
+	// CreateObjectOptions. The objectSize passed to PutObject is the size of the uncompressed payload.
+	Compression CompressionType
+	// DisableRetryBuffering indicates whether to skip buffering the payload which is not an io.Seeker in memory,
+	// in which case the failed upload request is not re-sent. The payload implementing io.Seeker is re-sent by
+	// seeking back to where the upload started.
+	DisableRetryBuffering bool
+}
+
+// PutObjectFromFileOptions contains the options of creating the object, uploading the payload from the local file
+// and waiting for the object to be sealed
+type PutObjectFromFileOptions struct {
+	CreateOpts CreateObjectOptions
+	PutOpts    PutObjectOptions
+	// DisableWaitSeal indicates whether to return once the payload is uploaded rather than waiting for the object to be sealed
+	DisableWaitSeal bool
+	// SealTimeout is the max duration of waiting for the object to be sealed, WaitSealTimeout is used if it is not set
+	SealTimeout time.Duration
+}
+
+// OverwriteStrategy indicates the order in which PutObjectOverwrite replaces an existing object. The objects are
+// immutable and can not be renamed, so the payload is always uploaded again under the object name.
+type OverwriteStrategy int
+
+const (
+	// OverwriteStaged uploads the payload to a staged object first, then deletes the existing object and uploads the
+	// payload under the object name, the staged object is deleted at last. The payload is kept in the staged object
+	// if the last upload fails, which costs an extra upload.
+	OverwriteStaged OverwriteStrategy = iota
+	// OverwriteDeleteFirst deletes the existing object and uploads the payload under the object name, the object is
+	// missing if the upload fails
+	OverwriteDeleteFirst
+)
+
+// PutObjectOverwriteOptions contains the options of replacing an object by PutObjectOverwrite
+type PutObjectOverwriteOptions struct {
+	Strategy OverwriteStrategy
+	// PutOpts is used to upload the staged object and the object, the staged objects are always waited to be sealed
+	PutOpts PutObjectFromFileOptions
+	// DeleteOpts is used to delete the existing object and the staged object
+	DeleteOpts DeleteObjectOption
+	// SpoolDir is the directory where the payload is spooled, the default temp directory is used if it is not set
+	SpoolDir string
+}
+
+// SpoolOptions contains the options of uploading the stream of unknown length by UploadStream
+type SpoolOptions struct {
+	CreateOpts CreateObjectOptions
+	PutOpts    PutObjectOptions
+	// SpoolDir is the directory where the segments of the stream are spooled, the default temp directory is used if it is not set
+	SpoolDir string
+	// MaxSize limits the total size of the stream, it is not limited if it is zero
+	MaxSize int64
+}
+
+// WatchObjectOptions contains the options of watching the status of an object
+type WatchObjectOptions struct {
+	// PollInterval is the interval of querying the object status if the client does not use the websocket connection,
+	// WatchObjectPollInterval is used if it is not set
+	PollInterval time.Duration
+}
+
+// RateLimitOptions configures a token bucket which limits the requests per second
+type RateLimitOptions struct {
+	// QPS is the sustained number of the requests per second, it should be positive
+	QPS float64
+	// Burst is the max number of the requests sent at once after being idle, 1 is used if it is not set
+	Burst int
+}
+
+// TrackCrossChainPackageOptions contains the options of tracking a cross-chain package by TrackCrossChainPackage
+type TrackCrossChainPackageOptions struct {
+	// PollInterval is the interval of querying the package status, TrackCrossChainPackageInterval is used if it is not set
+	PollInterval time.Duration
+	// Resolver reports the status of the package, the mirror packages are resolved by the mirror status of the
+	// resources on Greenfield if it is not set, the packages on the other channels require a resolver
+	Resolver CrossChainPackageResolver
+}
+
+// RequestDumpOptions contains the options of dumping the signed requests sent to SP
+type RequestDumpOptions struct {
+	// OnlyError indicates whether to dump only the requests which fail or get a non-2xx response
+	OnlyError bool
+	// ShowSignature indicates whether to dump the signature in the Authorization header rather than redacting it,
+	// which is required to replay the request. The signature is valid until the request expires, so the dump
+	// including it should not be shared publicly.
+	ShowSignature bool
 }
 
 // GetObjectOptions contains the options of getObject
@@ -207,6 +405,28 @@ type GetObjectOptions struct {
 	SupportRecovery  bool   // support recover data from secondary SPs if primary SP not in service
 	SupportResumable bool   // support resumable download. Resumable downloads refer to the capability of resuming interrupted or incomplete downloads from the point where they were paused or disrupted.
 	PartSize         uint64 // indicate the resumable download's part size, download a large file in multiple parts. The part size is an integer multiple of the segment size.
+
+	// Timeout is the total timeout of the download request including reading the body, which overrides the RequestTimeout of the client
+	Timeout time.Duration
+	// DisableDecompression indicates whether to return the stored payload of the compressed object as is, the
+	// payload is always returned as is if Range is set
+	DisableDecompression bool
+	// DisableCache indicates whether to bypass the object disk cache of the client
+	DisableCache bool
+	// QuotaGuard checks the remaining read quota of the bucket before downloading, it is disabled if it is nil
+	QuotaGuard *QuotaGuardOptions
+}
+
+// QuotaGuardOptions indicates how to handle the download whose size exceeds the remaining read quota of the bucket
+// in the current month, which would otherwise fail in the middle of the stream
+type QuotaGuardOptions struct {
+	// AutoPurchase increases the charged read quota of the bucket by the shortfall with the default account
+	AutoPurchase bool
+	// TxOpts is used to send the tx of purchasing the quota
+	TxOpts *gnfdsdktypes.TxOption
+	// OnInsufficient is called if the quota is insufficient and AutoPurchase is not set, the download continues if it
+	// returns nil. The download fails with ErrorInsufficientReadQuota if it is not set.
+	OnInsufficient func(bucketName string, required, remaining uint64) error
 }
 
 type GetChallengeInfoOptions struct {
@@ -226,6 +446,74 @@ type ListGroupsOptions struct {
 	EndPointOptions *EndPointOptions
 }
 
+// ActivityWindow is the range of the blocks scanned by GetBucketActivity, the heights take precedence over the times
+type ActivityWindow struct {
+	// FromHeight and ToHeight are the inclusive range of the block heights, ToHeight is the latest height if it is 0
+	FromHeight int64
+	ToHeight   int64
+	// Since and Until are resolved into the heights of the blocks committed in the range if the heights are not set,
+	// Until is the latest block if it is zero
+	Since time.Time
+	Until time.Time
+}
+
+// ExportStateOptions contains the options of exporting the resources of the account by ExportAccountState
+type ExportStateOptions struct {
+	// ExcludeObjects indicates whether to skip listing the objects of the buckets
+	ExcludeObjects bool
+	// PolicyPrincipals are the HEX-encoded addresses of the accounts whose policies are exported, the policies granted
+	// to the groups owned by the account are always exported. The chain can not list the policies of a resource, so
+	// the policies granted to the other accounts are not exported.
+	PolicyPrincipals []string
+	// IncludeObjectPolicies indicates whether to export the object policies, which queries the chain for every object
+	// and every principal
+	IncludeObjectPolicies bool
+	EndPointOptions       *EndPointOptions
+}
+
+// ImportPoliciesOptions contains the options of re-applying the policies of the account state by ImportPolicies
+type ImportPoliciesOptions struct {
+	TxOpts *gnfdsdktypes.TxOption
+	// BatchSize is the max number of the policies put in one transaction, DefaultImportPoliciesBatchSize is used if it is not set
+	BatchSize int
+	// SkipMissing indicates whether to skip the policies of the buckets, objects and groups which do not exist
+	// rather than failing
+	SkipMissing bool
+}
+
+// PlanOptions contains the options of planning the changes of the desired account state by PlanAccountState
+type PlanOptions struct {
+	// Prune indicates whether to delete the buckets, the groups and the policies which are not in the desired state.
+	// Only the policies of the groups owned by the account and of the PolicyPrincipals can be pruned, as the chain
+	// can not list the policies of a resource.
+	Prune bool
+	// PolicyPrincipals are the HEX-encoded addresses of the accounts whose policies are pruned
+	PolicyPrincipals []string
+	// Force indicates whether to prune the buckets protected by the delete protection patterns of the client
+	Force           bool
+	EndPointOptions *EndPointOptions
+}
+
+// ApplyOptions contains the options of applying a plan by ApplyAccountState
+type ApplyOptions struct {
+	TxOpts *gnfdsdktypes.TxOption
+	// BatchSize is the max number of the changes applied in one transaction, DefaultApplyBatchSize is used if it is not set
+	BatchSize int
+}
+
+// ListGroupsByOwnerOptions contains the options of listing the groups owned by an account
+type ListGroupsByOwnerOptions struct {
+	// Owner is the HEX-encoded address of the group owner, the default account is used if it is not set
+	Owner string
+	// NamePrefix limits the result to the groups whose names start with it
+	NamePrefix string
+	// StartAfter is the id of the group after which the listing starts, e.g. the NextStartAfter of the previous page
+	StartAfter string
+	// Limit is the max number of the groups listed from SP in a page, ListGroupsDefaultLimit is used if it is not set
+	Limit           int64
+	EndPointOptions *EndPointOptions
+}
+
 func (o *GetObjectOptions) SetRange(start, end int64) error {
 	switch {
 	case 0 < start && end == 0:
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The Validate methods check the options without querying the chain, they are invoked by the corresponding APIs so
+// that the misconfigurations fail before any request is sent. The zero values of the options are always valid.
+
+// Validate checks the visibility and the payment address
+func (o CreateBucketOptions) Validate() error {
+	if err := validateVisibility(o.Visibility, false); err != nil {
+		return err
+	}
+	return validateAddress("PaymentAddress", o.PaymentAddress)
+}
+
+// Validate checks the visibility and the payment address
+func (o UpdateBucketOptions) Validate() error {
+	if err := validateVisibility(o.Visibility, false); err != nil {
+		return err
+	}
+	return validateAddress("PaymentAddress", o.PaymentAddress)
+}
+
+// Validate checks the visibility and the compression
+func (o CreateObjectOptions) Validate() error {
+	if err := validateVisibility(o.Visibility, true); err != nil {
+		return err
+	}
+	return validateCompression(o.Compression)
+}
+
+// Validate checks the compression, the timeout and the user metadata
+func (o PutObjectOptions) Validate() error {
+	if err := validateCompression(o.Compression); err != nil {
+		return err
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("%w: Timeout %s is negative", ErrorInvalidOption, o.Timeout)
+	}
+	for key := range o.UserMetadata {
+		if key == "" || strings.ContainsAny(key, " \t\r\n:") {
+			return fmt.Errorf("%w: UserMetadata key %q is not a valid header name", ErrorInvalidOption, key)
+		}
+	}
+	return nil
+}
+
+// Validate checks the range and the timeout
+func (o GetObjectOptions) Validate() error {
+	if o.Range != "" && !strings.HasPrefix(o.Range, "bytes=") {
+		return fmt.Errorf("%w: Range %q should be in the form of bytes=N-M, see SetRange", ErrorInvalidOption, o.Range)
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("%w: Timeout %s is negative", ErrorInvalidOption, o.Timeout)
+	}
+	return nil
+}
+
+// Validate checks the delimiter, the max keys and the ranges of the filters
+func (o ListObjectsOptions) Validate() error {
+	if o.Delimiter != "" && o.Delimiter != "/" {
+		return fmt.Errorf("%w: Delimiter %q is not supported, only / is supported", ErrorInvalidOption, o.Delimiter)
+	}
+	if o.MaxKeys > ListObjectsMaxKeys {
+		return fmt.Errorf("%w: MaxKeys %d exceeds the max %d", ErrorInvalidOption, o.MaxKeys, ListObjectsMaxKeys)
+	}
+	if !o.CreatedAfter.IsZero() && !o.CreatedBefore.IsZero() && !o.CreatedAfter.Before(o.CreatedBefore) {
+		return fmt.Errorf("%w: CreatedAfter %s is not before CreatedBefore %s", ErrorInvalidOption, o.CreatedAfter, o.CreatedBefore)
+	}
+	if o.MaxSize > 0 && o.MinSize > o.MaxSize {
+		return fmt.Errorf("%w: MinSize %d exceeds MaxSize %d", ErrorInvalidOption, o.MinSize, o.MaxSize)
+	}
+	return validateEndPoint(o.EndPointOptions)
+}
+
+// Validate checks the batch size and the interval
+func (o DeleteObjectsOptions) Validate() error {
+	if o.BatchSize < 0 {
+		return fmt.Errorf("%w: BatchSize %d is negative", ErrorInvalidOption, o.BatchSize)
+	}
+	if o.BatchInterval < 0 {
+		return fmt.Errorf("%w: BatchInterval %s is negative", ErrorInvalidOption, o.BatchInterval)
+	}
+	return validateEndPoint(o.EndPointOptions)
+}
+
+// Validate checks the prefetch and the download options
+func (o DownloadPrefixOptions) Validate() error {
+	if o.Prefetch < 0 {
+		return fmt.Errorf("%w: Prefetch %d is negative", ErrorInvalidOption, o.Prefetch)
+	}
+	if err := o.GetObjectOptions.Validate(); err != nil {
+		return err
+	}
+	return validateEndPoint(o.EndPointOptions)
+}
+
+// Validate checks the strategy and the options of uploading and deleting
+func (o PutObjectOverwriteOptions) Validate() error {
+	if o.Strategy != OverwriteStaged && o.Strategy != OverwriteDeleteFirst {
+		return fmt.Errorf("%w: unknown Strategy %d", ErrorInvalidOption, o.Strategy)
+	}
+	if err := o.PutOpts.CreateOpts.Validate(); err != nil {
+		return err
+	}
+	return o.PutOpts.PutOpts.Validate()
+}
+
+// Validate checks the owner and the limit
+func (o ListGroupsByOwnerOptions) Validate() error {
+	if err := validateAddress("Owner", o.Owner); err != nil {
+		return err
+	}
+	if o.Limit < 0 || o.Limit > ListGroupsMaxLimit {
+		return fmt.Errorf("%w: Limit %d is out of the range [0, %d]", ErrorInvalidOption, o.Limit, ListGroupsMaxLimit)
+	}
+	return validateEndPoint(o.EndPointOptions)
+}
+
+// Validate checks the ranges of the heights and the times
+func (w ActivityWindow) Validate() error {
+	if w.FromHeight < 0 || w.ToHeight < 0 {
+		return fmt.Errorf("%w: the heights of the window should not be negative", ErrorInvalidOption)
+	}
+	if w.ToHeight != 0 && w.FromHeight > w.ToHeight {
+		return fmt.Errorf("%w: FromHeight %d exceeds ToHeight %d", ErrorInvalidOption, w.FromHeight, w.ToHeight)
+	}
+	if !w.Since.IsZero() && !w.Until.IsZero() && !w.Since.Before(w.Until) {
+		return fmt.Errorf("%w: Since %s is not before Until %s", ErrorInvalidOption, w.Since, w.Until)
+	}
+	return nil
+}
+
+// Validate checks the segment can be split into the data shards
+func (o ComputeHashOptions) Validate() error {
+	if o.SegmentSize != 0 && o.DataShards != 0 && o.SegmentSize < uint64(o.DataShards) {
+		return fmt.Errorf("%w: SegmentSize %d is less than DataShards %d", ErrorInvalidOption, o.SegmentSize, o.DataShards)
+	}
+	return nil
+}
+
+// Validate checks the SP address
+func (o EndPointOptions) Validate() error {
+	return validateAddress("SPAddress", o.SPAddress)
+}
+
+func validateEndPoint(o *EndPointOptions) error {
+	if o == nil {
+		return nil
+	}
+	return o.Validate()
+}
+
+func validateVisibility(visibility storageTypes.VisibilityType, allowInherit bool) error {
+	if _, ok := storageTypes.VisibilityType_name[int32(visibility)]; !ok {
+		return fmt.Errorf("%w: unknown Visibility %d", ErrorInvalidOption, visibility)
+	}
+	if visibility == storageTypes.VISIBILITY_TYPE_INHERIT && !allowInherit {
+		return fmt.Errorf("%w: Visibility %s is only allowed for the objects", ErrorInvalidOption, visibility)
+	}
+	return nil
+}
+
+func validateCompression(compression CompressionType) error {
+	switch compression {
+	case CompressionNone, CompressionGzip, CompressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown Compression %q", ErrorInvalidOption, compression)
+	}
+}
+
+// validateAddress checks the address is HEX-encoded if it is set
+func validateAddress(field, address string) error {
+	if address == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(address); err != nil {
+		return fmt.Errorf("%w: %s %q is not a HEX-encoded address: %v", ErrorInvalidOption, field, address, err)
+	}
+	return nil
+}
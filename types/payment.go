@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	paymentTypes "github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// StreamSettlement is the settlement status of a stream record at the block time of the query
+type StreamSettlement struct {
+	Account string
+	Status  paymentTypes.StreamAccountStatus
+	// NetflowRate is the per-second change of the balance, the record is only settled automatically if it is negative
+	NetflowRate math.Int
+	// DynamicBalance is the static balance plus the flow since the latest update of the record
+	DynamicBalance math.Int
+	BufferBalance  math.Int
+	BankBalance    math.Int
+	// SettleTimestamp is the unix time at which the record is settled automatically, it is zero if the record is not
+	// settled automatically
+	SettleTimestamp int64
+	// AtRisk indicates whether the record is frozen, or is settled automatically within the warning window. A record is
+	// frozen at the settlement if its balance can not reserve the outflows of the next reserve time, which fails the
+	// uploads charged to it.
+	AtRisk bool
+}
+
+// TimeToSettle returns the duration from the query until the automatic settlement, it is zero if the record is not
+// settled automatically
+func (s StreamSettlement) TimeToSettle(now time.Time) time.Duration {
+	if s.SettleTimestamp == 0 {
+		return 0
+	}
+	return time.Unix(s.SettleTimestamp, 0).Sub(now)
+}
+
+// SettlementSummary summarizes the stream records of an owner and its payment accounts
+type SettlementSummary struct {
+	Owner string
+	// Timestamp is the unix block time at which the records are queried
+	Timestamp int64
+	// Records are sorted by SettleTimestamp, the records which are not settled automatically are placed at the end
+	Records []StreamSettlement
+}
+
+// AtRisk returns the records which are frozen or are settled automatically within the warning window
+func (s *SettlementSummary) AtRisk() []StreamSettlement {
+	var records []StreamSettlement
+	for _, record := range s.Records {
+		if record.AtRisk {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// SettlementSummaryOptions contains the options of summarizing the stream records by GetSettlementSummary
+type SettlementSummaryOptions struct {
+	// WarningWindow marks the records settled automatically within the window as at risk,
+	// DefaultSettlementWarningWindow is used if it is not set
+	WarningWindow time.Duration
+}
+
+// AccountFreezeStatus is the freeze status of a stream record. A frozen record stops the outflows and the SPs reject
+// the uploads charged to it, it is resumed by depositing the shortfall of the reserved balance.
+type AccountFreezeStatus struct {
+	Account string
+	Frozen  bool
+	// StaticBalance is the balance of the record which is used to reserve the outflows on resuming
+	StaticBalance math.Int
+	BankBalance   math.Int
+	// ReserveBalance is the balance reserved for the outflows of the reserve time, including the frozen outflows
+	ReserveBalance math.Int
+	// DepositToResume is the amount to deposit to resume the frozen record, it is zero if the record is not frozen
+	DepositToResume math.Int
+	// OutFlowCount is the number of the outflows, the record with more outflows than the MaxAutoResumeFlowCount of the
+	// payment params is resumed over several blocks after the deposit
+	OutFlowCount uint64
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NetworkPreset is the configuration of a public Greenfield network. The storage providers are not part of the
+// preset as they are discovered from the chain.
+type NetworkPreset struct {
+	Name    string
+	ChainID string
+	// RpcAddress is the tendermint rpc endpoint of a full node of the network
+	RpcAddress string
+	// GrpcAddress is the gRPC endpoint of a full node of the network, the queries and the txs are sent over the rpc
+	// endpoint if it is empty
+	GrpcAddress string
+}
+
+// The names of the built-in network presets
+const (
+	NetworkTestnet = "testnet"
+	NetworkMainnet = "mainnet"
+)
+
+var (
+	networkPresetsMutex sync.RWMutex
+	networkPresets      = map[string]NetworkPreset{
+		NetworkTestnet: {
+			Name:       NetworkTestnet,
+			ChainID:    "greenfield_5600-1",
+			RpcAddress: "https://gnfd-testnet-fullnode-tendermint-us.bnbchain.org:443",
+		},
+		NetworkMainnet: {
+			Name:       NetworkMainnet,
+			ChainID:    "greenfield_1017-1",
+			RpcAddress: "https://greenfield-chain.bnbchain.org:443",
+		},
+	}
+)
+
+// GetNetworkPreset returns the preset of the name case-insensitively, e.g. NetworkTestnet
+func GetNetworkPreset(name string) (NetworkPreset, error) {
+	networkPresetsMutex.RLock()
+	defer networkPresetsMutex.RUnlock()
+	preset, ok := networkPresets[strings.ToLower(name)]
+	if !ok {
+		names := make([]string, 0, len(networkPresets))
+		for presetName := range networkPresets {
+			names = append(names, presetName)
+		}
+		sort.Strings(names)
+		return NetworkPreset{}, fmt.Errorf("%w: unknown network preset %q, the presets are %s", ErrorInvalidOption, name, strings.Join(names, ", "))
+	}
+	return preset, nil
+}
+
+// RegisterNetworkPreset adds or replaces the preset of its name, e.g. for the private deployments or for pointing the
+// built-in presets to other full nodes
+func RegisterNetworkPreset(preset NetworkPreset) error {
+	if preset.Name == "" || preset.ChainID == "" || preset.RpcAddress == "" {
+		return fmt.Errorf("%w: the name, the chain id and the rpc address of the network preset should be set", ErrorInvalidOption)
+	}
+	preset.Name = strings.ToLower(preset.Name)
+	networkPresetsMutex.Lock()
+	defer networkPresetsMutex.Unlock()
+	networkPresets[preset.Name] = preset
+	return nil
+}
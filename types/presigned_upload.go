@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// PresignedUpload is the PUT request of the object payload signed by the account of the backend, which is handed to
+// a browser to upload the payload to the SP directly. The browser sends the request to the URL with the headers and
+// the payload as the body before ExpiresAt.
+type PresignedUpload struct {
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// PresignPutObjectOptions indicates the request signed by PresignPutObject
+type PresignPutObjectOptions struct {
+	// ContentType is the content type of the payload, which is signed so the browser must send the same one.
+	// ContentDefault is used if it is not set.
+	ContentType string
+	// Expiry is how long the request is authorized, DefaultPresignExpiry is used if it is not set, and it can not
+	// exceed the max expiry of the SP, which is seven days
+	Expiry time.Duration
+	// TxnHash is the hash of the createObject tx, which is sent to the SP as the uploads of PutObject
+	TxnHash string
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"context"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithQueryHeight returns a copy of the context which pins the chain queries made with it to the block height, e.g.
+// HeadBucket, HeadObject and the policy queries, by the x-cosmos-block-height header. The height pinned by the parent
+// context is replaced, and the state of the height must not have been pruned by the node. The context should only be
+// used for queries, as the txs built with it would be signed with the sequence of the account at the height.
+func WithQueryHeight(ctx context.Context, height int64) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// QueryHeightFromContext returns the block height pinned by WithQueryHeight, or 0 if the queries are made at the
+// latest height
+func QueryHeightFromContext(ctx context.Context) int64 {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return 0
+	}
+	height, _ := strconv.ParseInt(heights[0], 10, 64)
+	return height
+}
@@ -0,0 +1,33 @@
+package types
+
+import "strings"
+
+// SPEndpointResolver maps the storage providers to the endpoints the client connects to, e.g. the internal endpoints
+// of the SPs running inside a VPC or behind a service mesh, instead of the public endpoints registered on chain
+type SPEndpointResolver interface {
+	// ResolveSPEndpoint returns the endpoint of the SP identified by the HEX-encoded operator address, e.g.
+	// "http://sp0.internal:9033", or an empty string to use the on-chain endpoint
+	ResolveSPEndpoint(operatorAddress string, onChainEndpoint string) (string, error)
+}
+
+// SPEndpointResolverFunc is an adapter to allow the use of ordinary functions as SPEndpointResolver
+type SPEndpointResolverFunc func(operatorAddress string, onChainEndpoint string) (string, error)
+
+// ResolveSPEndpoint calls f(operatorAddress, onChainEndpoint)
+func (f SPEndpointResolverFunc) ResolveSPEndpoint(operatorAddress string, onChainEndpoint string) (string, error) {
+	return f(operatorAddress, onChainEndpoint)
+}
+
+// StaticSPEndpointResolver maps the HEX-encoded operator addresses to the endpoints, the SPs not in the map use
+// their on-chain endpoints
+type StaticSPEndpointResolver map[string]string
+
+// ResolveSPEndpoint looks up the operator address case-insensitively
+func (r StaticSPEndpointResolver) ResolveSPEndpoint(operatorAddress string, _ string) (string, error) {
+	for address, endpoint := range r {
+		if strings.EqualFold(address, operatorAddress) {
+			return endpoint, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"sigs.k8s.io/yaml"
+)
+
+// AccountState is the declarative snapshot of the resources owned by an account, which is exported by
+// ExportAccountState and serialized as a JSON manifest. The enums are recorded by their names, e.g.
+// "VISIBILITY_TYPE_PRIVATE", so the manifest stays readable and can be edited by hand. It is also the desired state
+// planned by PlanAccountState, where the informative fields, e.g. the ids, are ignored.
+type AccountState struct {
+	Owner      string    `json:"owner"`
+	ExportTime time.Time `json:"export_time"`
+	// Height is the latest block height when the export started
+	Height          int64                  `json:"height"`
+	Buckets         []*BucketState         `json:"buckets"`
+	Groups          []*GroupState          `json:"groups"`
+	PaymentAccounts []*PaymentAccountState `json:"payment_accounts"`
+}
+
+// ParseAccountState parses the manifest of the account state in either JSON or YAML, which uses the same field names
+// as the JSON manifest
+func ParseAccountState(data []byte) (*AccountState, error) {
+	state := &AccountState{}
+	if err := yaml.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// BucketState is the metadata and the policies of a bucket
+type BucketState struct {
+	BucketName string `json:"bucket_name"`
+	// BucketID is only informative, it differs once the bucket is recreated
+	BucketID   string `json:"bucket_id,omitempty"`
+	Visibility string `json:"visibility"`
+	// PrimarySPAddress is the operator address of the primary SP
+	PrimarySPAddress string `json:"primary_sp_address,omitempty"`
+	PaymentAddress   string `json:"payment_address,omitempty"`
+	// ChargedReadQuota is the charged read quota in bytes, the quota of an existing bucket is kept if it is omitted,
+	// and a bucket is created without charged quota
+	ChargedReadQuota *uint64        `json:"charged_read_quota,omitempty"`
+	Objects          []*ObjectState `json:"objects,omitempty"`
+	Policies         []*PolicyState `json:"policies,omitempty"`
+}
+
+// ObjectState is the metadata and the policies of an object, the payload is not included
+type ObjectState struct {
+	ObjectName     string `json:"object_name"`
+	ObjectID       string `json:"object_id,omitempty"`
+	PayloadSize    uint64 `json:"payload_size"`
+	ContentType    string `json:"content_type"`
+	Visibility     string `json:"visibility"`
+	Status         string `json:"status"`
+	RedundancyType string `json:"redundancy_type"`
+	// Checksums are the HEX-encoded checksums of the object, the first one is the checksum of the whole payload
+	Checksums []string       `json:"checksums,omitempty"`
+	Policies  []*PolicyState `json:"policies,omitempty"`
+}
+
+// GroupState is a group owned by the account
+type GroupState struct {
+	GroupName string `json:"group_name"`
+	// GroupID is only informative, it differs once the group is recreated
+	GroupID string `json:"group_id,omitempty"`
+	Extra   string `json:"extra,omitempty"`
+	// Members are the HEX-encoded addresses of the group members, they are added to the group when the desired state
+	// is applied but the other members are kept, as the members of a group can not be listed
+	Members []string `json:"members,omitempty"`
+}
+
+// PolicyState is a policy granted to either an account or a group owned by the account
+type PolicyState struct {
+	// PrincipalAccount is the HEX-encoded address of the account which the policy is granted to
+	PrincipalAccount string `json:"principal_account,omitempty"`
+	// PrincipalGroup is the name of the group which the policy is granted to, the group is owned by the owner of
+	// the resource, so it is resolved into the group of the same name when the policy is applied by another account
+	PrincipalGroup string                 `json:"principal_group,omitempty"`
+	Statements     []*permTypes.Statement `json:"statements"`
+	ExpirationTime *time.Time             `json:"expiration_time,omitempty"`
+}
+
+// ImportPoliciesResult is the result of ImportPolicies
+type ImportPoliciesResult struct {
+	// Applied is the number of the policies put
+	Applied int
+	// Skipped describes the policies which are skipped as their resources or groups do not exist
+	Skipped  []string
+	TxHashes []string
+}
+
+// PaymentAccountState is a payment account owned by the account
+type PaymentAccountState struct {
+	Address    string `json:"address"`
+	Refundable bool   `json:"refundable"`
+}
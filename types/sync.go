@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// SyncStatus is the sync status of the chain node the client is connected to
+type SyncStatus struct {
+	// CatchingUp indicates whether the node is still catching up with the chain after starting
+	CatchingUp        bool
+	LatestBlockHeight int64
+	LatestBlockTime   time.Time
+	// BlockInterval is the average interval of the recent blocks of the node
+	BlockInterval time.Duration
+	// BlocksBehind estimates the number of the blocks the node lags behind the chain by the age of its latest block
+	BlocksBehind int64
+}
+
+// SyncGuardOptions configures the client to refuse the requests sent to the chain node which is lagging, so that
+// the objects are not downloaded or uploaded with the permissions checked against a stale state
+type SyncGuardOptions struct {
+	// MaxBlockLag is the max number of the blocks the node is allowed to lag behind, it should be positive
+	MaxBlockLag int64
+	// CheckInterval is how long the sync status of the node is reused before it is checked again,
+	// DefaultSyncGuardCheckInterval is used if it is not set
+	CheckInterval time.Duration
+}
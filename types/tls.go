@@ -0,0 +1,55 @@
+package types
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSOptions configures the TLS connections to the chain node and the storage providers, e.g. to trust the CA of a
+// corporate network or to authenticate the client by a certificate
+type TLSOptions struct {
+	// CACertFile is the PEM-encoded CA bundle trusted in addition to the system roots
+	CACertFile string
+	// ClientCertFile and ClientKeyFile are the PEM-encoded certificate and key presented for mTLS, both or neither
+	// should be set
+	ClientCertFile string
+	ClientKeyFile  string
+	// InsecureSkipVerify disables the verification of the server certificates, it should only be used in tests
+	InsecureSkipVerify bool
+}
+
+// Config loads the files into a tls.Config
+func (o *TLSOptions) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec
+	}
+	if o.CACertFile != "" {
+		pem, err := os.ReadFile(o.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate is found in the CA file %s", o.CACertFile)
+		}
+		config.RootCAs = pool
+	}
+	if (o.ClientCertFile == "") != (o.ClientKeyFile == "") {
+		return nil, errors.New("the client certificate and key files should be set together")
+	}
+	if o.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.ClientCertFile, o.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
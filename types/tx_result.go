@@ -0,0 +1,14 @@
+package types
+
+import "github.com/cosmos/gogoproto/proto"
+
+// TxResult is the result of a committed transaction returned by the AndWait variants of the write APIs
+type TxResult struct {
+	// Hash is the hash of the transaction
+	Hash string
+	// Height is the height of the block including the transaction
+	Height int64
+	// Events are the typed events emitted by the transaction, e.g. *storagetypes.EventCreateBucket, which can be
+	// picked by events.FindEvent
+	Events []proto.Message
+}
@@ -3,13 +3,17 @@ package types
 import (
 	"io"
 	"math/rand"
+	"mime"
 	"net/url"
+	"strings"
 	"time"
 
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	spTypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
@@ -18,11 +22,37 @@ var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 // user can generate it by NewPrincipalWithAccount or NewPrincipalWithGroupId method in utils
 type Principal string
 
+// CompressionType indicates the algorithm which compresses the payload of the object before uploading
+type CompressionType string
+
+const (
+	CompressionNone CompressionType = ""
+	CompressionGzip CompressionType = "gzip"
+	CompressionZstd CompressionType = "zstd"
+)
+
+// ParseContentEncoding returns the content type without the compression parameter and the compression recorded in it
+func ParseContentEncoding(contentType string) (string, CompressionType) {
+	if !strings.Contains(contentType, ContentEncodingParam) {
+		return contentType, CompressionNone
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType, CompressionNone
+	}
+	compression := CompressionType(params[ContentEncodingParam])
+	delete(params, ContentEncodingParam)
+	return mime.FormatMediaType(mediaType, params), compression
+}
+
 // ObjectStat contains the metadata of downloaded objects
 type ObjectStat struct {
 	ObjectName  string
 	ContentType string
-	Size        int64 // Object size
+	Size        int64 // Object size, it is -1 if the payload is decompressed by GetObject
+
+	// ContentEncoding is the compression of the stored payload, which is recorded in the content type of the object
+	ContentEncoding CompressionType
 }
 
 // ObjectInfo
@@ -69,3 +99,172 @@ type StorageProvider struct {
 	Description     spTypes.Description
 	BlsKey          []byte
 }
+
+// SPHealth indicates the availability and response time of the SP probed by the health checker
+type SPHealth struct {
+	Id            uint32
+	Endpoint      string
+	Available     bool
+	Latency       time.Duration // the response time of the last probe
+	LastCheckTime time.Time
+	LastError     string // the error info of the last probe if the SP is unavailable
+}
+
+// UnsignedTx contains an unsigned transaction built by the online client and the digest that
+// the signer needs to sign, it is used by the air-gapped signing workflows
+type UnsignedTx struct {
+	TxBytes       []byte // the encoded transaction carrying the signer info but no signature
+	SignBytes     []byte // the EIP-712 digest to be signed by the signer
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// UploadPermit is the signed authorization of uploading the payload of an object to the SP.
+// The holder of the permit performs the HTTP PUT request to URL with Headers and the object payload as body,
+// without access to the private key of the signer.
+type UploadPermit struct {
+	Method      string
+	URL         string
+	Host        string
+	Headers     map[string]string
+	BucketName  string
+	ObjectName  string
+	ObjectSize  int64
+	Signer      string    // the HEX-encoded address of the signer
+	ExpiresTime time.Time // the permit can not be used after the expiry time
+}
+
+// DeleteObjectsResult contains the objects deleted, or to be deleted in dry-run mode, and the hashes of the transactions
+type DeleteObjectsResult struct {
+	ObjectNames []string
+	TxHashes    []string
+}
+
+// DryRunResult contains the msgs constructed by the write API and the gas and fee estimated by simulation,
+// the msgs are validated and simulated without being broadcast
+type DryRunResult struct {
+	Msgs     []sdk.Msg
+	GasLimit uint64
+	GasPrice sdk.Coin
+	Fee      sdk.Coins
+}
+
+// PermissionRule indicates the rule of the permission verification which produces the effect
+type PermissionRule string
+
+const (
+	PermissionRulePublicVisibility PermissionRule = "PublicVisibility" // the read action is allowed by the public visibility
+	PermissionRuleOwner            PermissionRule = "Owner"            // the owner has full permissions
+	PermissionRuleBucketPolicy     PermissionRule = "BucketPolicy"     // the bucket policy of the user
+	PermissionRuleObjectPolicy     PermissionRule = "ObjectPolicy"     // the object policy of the user
+	PermissionRuleGroupPolicy      PermissionRule = "GroupPolicy"      // the policy of a group which the user is member of
+	PermissionRuleDefaultDeny      PermissionRule = "DefaultDeny"      // no rule allows the action
+)
+
+// PermissionExplanation explains the effect of the permission verification. Effect is verified by chain,
+// and Rule and Policy are derived from the visibility, the owner and the policies of the user.
+type PermissionExplanation struct {
+	Effect permTypes.Effect
+	Rule   PermissionRule
+	// Policy is the policy of the user which produces the effect, it is nil unless Rule is BucketPolicy or ObjectPolicy
+	Policy *permTypes.Policy
+}
+
+// ResourceAction indicates the action on the bucket, or on the object if ObjectName is not empty, to be verified
+type ResourceAction struct {
+	BucketName string
+	ObjectName string
+	Action     permTypes.ActionType
+}
+
+// ObjectSealStatus is the seal status of an object returned by GetObjectsSealStatus
+type ObjectSealStatus struct {
+	ObjectName string
+	// Status is the status of the object on chain, it is meaningless if Err is not nil
+	Status storagetypes.ObjectStatus
+	Sealed bool
+	// Err is the error of querying the object, e.g. the object does not exist
+	Err error
+}
+
+// ObjectDownload is an object returned by DownloadPrefix, the consumer should read and close Body
+type ObjectDownload struct {
+	Object *ObjectMeta
+	Stat   ObjectStat
+	Body   io.ReadCloser
+}
+
+// ObjectStatusEvent is the status transition of the object delivered by WatchObject
+type ObjectStatusEvent struct {
+	// Status is the new status of the object, it is meaningless if Deleted is true
+	Status storagetypes.ObjectStatus
+	// Deleted indicates the object has been deleted, it is the last event of the watch
+	Deleted    bool
+	ObjectInfo *storagetypes.ObjectInfo
+	// Time is when the transition is observed
+	Time time.Time
+}
+
+// BucketQuotaSummary is the read quota of a bucket in the current month. The quota which can be consumed in the month
+// is the sum of the ChargedQuota paid on chain and the SPFreeQuota granted by the primary SP.
+type BucketQuotaSummary struct {
+	BucketName string
+	BucketID   string
+	// Month is the month of the quota in the format of "2006-01"
+	Month string
+	// ChargedQuota is the read quota bought by the bucket owner, which is queried from the chain
+	ChargedQuota uint64
+	// SPFreeQuota is the free read quota granted by the primary SP in the month
+	SPFreeQuota uint64
+	// ConsumedQuota is the read quota consumed in the month, which is counted by the primary SP
+	ConsumedQuota uint64
+}
+
+// TotalQuota returns the read quota which can be consumed in the month
+func (s BucketQuotaSummary) TotalQuota() uint64 {
+	return s.ChargedQuota + s.SPFreeQuota
+}
+
+// RemainingQuota returns the read quota which can still be consumed in the month
+func (s BucketQuotaSummary) RemainingQuota() uint64 {
+	if total := s.TotalQuota(); total > s.ConsumedQuota {
+		return total - s.ConsumedQuota
+	}
+	return 0
+}
+
+// BucketActivity is a chain event related to the bucket found by GetBucketActivity
+type BucketActivity struct {
+	Height int64
+	Time   time.Time
+	// TxHash is the hash of the transaction emitting the event, it is empty for the events emitted by the chain
+	// itself at the end of the block, e.g. storagetypes.EventDiscontinueBucket
+	TxHash string
+	// Event is the typed event, e.g. *storagetypes.EventCreateObject or *permTypes.EventPutPolicy
+	Event proto.Message
+}
+
+// DailyReadUsage is the read quota consumed by a bucket in a day
+type DailyReadUsage struct {
+	Date      time.Time // the beginning of the day in the local time zone
+	ReadSize  uint64
+	ReadCount int
+}
+
+// QuotaUsageTrend aggregates the read records of the bucket in the current month and projects when the read quota
+// will be exhausted at the average daily consumption
+type QuotaUsageTrend struct {
+	BucketName string
+	Quota      BucketQuotaSummary
+	// Remaining is the read quota which can still be consumed in the current month
+	Remaining uint64
+	// Daily is the consumption of each day from the beginning of the month to today
+	Daily             []DailyReadUsage
+	AverageDailyUsage uint64
+	// ProjectedExhaustion is the time the remaining quota runs out at the average daily usage, it is nil if no quota
+	// has been consumed in the month
+	ProjectedExhaustion *time.Time
+	// ExhaustedBeforeReset indicates whether the quota is projected to run out before it is reset at the next month
+	ExhaustedBeforeReset bool
+}